@@ -0,0 +1,190 @@
+package wagering
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// Arb is the allocation of a total stake across every outcome of a market so that
+// the same return is made whichever outcome occurs.
+type Arb struct {
+	// Stakes holds the amount wagered on each outcome.
+	Stakes []float64
+	// Profit is the worst case profit across the outcomes.
+	Profit float64
+}
+
+// arb returns the Arb splitting totalStake across the outcomes at the given odds
+// in proportion to their implied probabilities.
+func arb(totalStake float64, odds ...Odds) Arb {
+	probSum := probSum(odds...)
+	var stakes []float64
+	for _, o := range odds {
+		stakes = append(stakes, totalStake/(o.decimalOdds*probSum))
+	}
+	return Arb{Stakes: stakes, Profit: totalStake/probSum - totalStake}
+}
+
+// Arbitrage returns the split of totalStake across the two sides of a two way
+// market at the given odds, typically from different books, that guarantees the
+// same return regardless of the outcome, and whether that return is a profit.
+func Arbitrage(oddsA, oddsB Odds, totalStake float64) (Arb, bool) {
+	a := arb(totalStake, oddsA, oddsB)
+	return a, a.Profit > 0.0
+}
+
+// worstCaseProfit returns the smallest profit across the outcomes at the given odds
+// when wagering the given stakes.
+func worstCaseProfit(stakes []float64, odds []Odds) float64 {
+	total := 0.0
+	for _, s := range stakes {
+		total += s
+	}
+	worst := math.Inf(1)
+	for i, o := range odds {
+		worst = math.Min(worst, stakes[i]*o.decimalOdds-total)
+	}
+	return worst
+}
+
+// maxRoundedStakes is the most stakes for which roundStakes searches every choice
+// of rounding up or down.
+const maxRoundedStakes = 16
+
+// roundStakes returns the stakes wagered at the given odds rounded to multiples of
+// increment without their total exceeding totalStake, the worst case profit across
+// the outcomes after rounding, and whether every outcome is still staked. As
+// rounding each stake to the nearest increment can turn an arbitrage into a loss
+// or overspend totalStake, every stake is rounded down and the increments left
+// over handed out by rounding stakes up. Every choice of stakes to round up is
+// considered and the one with the largest worst case profit is returned,
+// preferring the nearest rounding on ties. Beyond maxRoundedStakes stakes the
+// increments go to the stakes furthest below their unrounded amount. If no
+// rounding stakes every outcome the stakes rounded down are returned with false.
+func roundStakes(stakes []float64, odds []Odds, increment, totalStake float64) ([]float64, float64, bool) {
+	down := make([]float64, len(stakes))
+	up := make([]float64, len(stakes))
+	spent := 0.0
+	for i, s := range stakes {
+		down[i] = RoundStake(s, increment, RoundDown)
+		up[i] = RoundStake(s, increment, RoundUp)
+		spent += down[i]
+	}
+	// Guard against representation error as in RoundStake.
+	spare := int(math.Floor((totalStake-spent)/increment + 1e-9))
+
+	var best []float64
+	bestProfit, bestDistance := math.Inf(-1), math.Inf(1)
+	// consider records candidate as the best if it stakes every outcome and beats
+	// the best so far.
+	consider := func(candidate []float64) {
+		distance := 0.0
+		for i, c := range candidate {
+			if c <= 0.0 {
+				return
+			}
+			distance += math.Abs(c - stakes[i])
+		}
+		profit := worstCaseProfit(candidate, odds)
+		if profit > bestProfit+1e-9 || (profit > bestProfit-1e-9 && distance < bestDistance) {
+			best = append(best[:0], candidate...)
+			bestProfit, bestDistance = profit, distance
+		}
+	}
+
+	candidate := make([]float64, len(stakes))
+	if len(stakes) <= maxRoundedStakes {
+		for mask := 0; mask < 1<<uint(len(stakes)); mask++ {
+			if bits.OnesCount(uint(mask)) > spare {
+				continue
+			}
+			for i := range stakes {
+				if mask&(1<<uint(i)) != 0 {
+					candidate[i] = up[i]
+				} else {
+					candidate[i] = down[i]
+				}
+			}
+			consider(candidate)
+		}
+	} else {
+		order := make([]int, len(stakes))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return stakes[order[a]]-down[order[a]] > stakes[order[b]]-down[order[b]]
+		})
+		copy(candidate, down)
+		for _, i := range order[:min(spare, len(order))] {
+			candidate[i] = up[i]
+		}
+		consider(candidate)
+	}
+	if best == nil {
+		return down, worstCaseProfit(down, odds), false
+	}
+	return best, bestProfit, true
+}
+
+// ArbitrageN returns the split of totalStake across every outcome of a market at
+// the given odds, typically the best prices across books, that guarantees a return
+// regardless of the outcome, and whether that return is a profit. When increment
+// is positive each stake is rounded to a multiple of increment, for example 1.0
+// for whole currency units, choosing to round each up or down to maximize the
+// worst case profit without staking more than totalStake in all, and the reported
+// Profit is that worst case across the outcomes after rounding. If no rounding
+// stakes every outcome within totalStake the stakes are rounded down and false is
+// returned.
+func ArbitrageN(totalStake, increment float64, odds ...Odds) (Arb, bool) {
+	a := arb(totalStake, odds...)
+	ok := true
+	if increment > 0.0 {
+		a.Stakes, a.Profit, ok = roundStakes(a.Stakes, odds, increment, totalStake)
+	}
+	return a, ok && a.Profit > 0.0
+}
+
+// BestOdds returns the book quoting the longest odds, the best price for a bettor,
+// and those odds given a map from book to the odds it quotes. Ties are broken by
+// the book name for determinism.
+func BestOdds(quotes map[string]Odds) (string, Odds) {
+	var book string
+	var best Odds
+	for b, o := range quotes {
+		if book == "" || o.Longer(best) || (o.Equals(best) && b < book) {
+			book, best = b, o
+		}
+	}
+	return book, best
+}
+
+// hold returns the theoretical hold, as a decimal, of a market at the given odds.
+func hold(odds ...Odds) float64 {
+	return 1.0 - 1.0/probSum(odds...)
+}
+
+// SyntheticHold returns the theoretical hold, as a decimal, of the synthetic market
+// made by taking the best price on each side of a market from several books, and
+// the book offering that price for each side. Each side is given as a map from book
+// to the odds the book quotes for that side. A negative hold is an arbitrage.
+func SyntheticHold(sides ...map[string]Odds) (float64, []string) {
+	books, odds := BestLine(sides...)
+	return hold(odds...), books
+}
+
+// BestLine returns the synthetic best line market made by taking the best price on
+// each outcome of a market across several books, the book offering each price and
+// the prices. Each outcome is given as a map from book to the odds the book quotes
+// for that outcome.
+func BestLine(outcomes ...map[string]Odds) ([]string, []Odds) {
+	var books []string
+	var odds []Odds
+	for _, quotes := range outcomes {
+		book, best := BestOdds(quotes)
+		books = append(books, book)
+		odds = append(odds, best)
+	}
+	return books, odds
+}
@@ -0,0 +1,98 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestArbitrage(t *testing.T) {
+	a, ok := Arbitrage(NewOddsFromDecimal(2.1), NewOddsFromDecimal(2.1), 1000.0)
+	assert.True(t, ok)
+	assert.InDelta(t, 500.0, a.Stakes[0], 1e-9)
+	assert.InDelta(t, 500.0, a.Stakes[1], 1e-9)
+	assert.InDelta(t, 50.0, a.Profit, 1e-9)
+
+	a, ok = Arbitrage(NewOddsFromAmerican(+110.0), NewOddsFromAmerican(-105.0), 1000.0)
+	assert.True(t, ok)
+	assert.InDelta(t, 481.79, a.Stakes[0], 0.01)
+	assert.InDelta(t, 518.21, a.Stakes[1], 0.01)
+	assert.InDelta(t, 11.75, a.Profit, 0.01)
+
+	_, ok = Arbitrage(NewOddsFromAmerican(-110.0), NewOddsFromAmerican(-110.0), 1000.0)
+	assert.False(t, ok)
+}
+
+func TestArbitrageN(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(2.6), NewOddsFromDecimal(3.5), NewOddsFromDecimal(3.4)}
+	a, ok := ArbitrageN(1000.0, 0.0, odds...)
+	assert.True(t, ok)
+	assert.InDelta(t, 1000.0, a.Stakes[0]+a.Stakes[1]+a.Stakes[2], 1e-9)
+	assert.InDelta(t, 36.86, a.Profit, 0.01)
+	assert.InDelta(t, a.Profit, worstCaseProfit(a.Stakes, odds), 1e-9)
+
+	a, ok = ArbitrageN(1000.0, 1.0, odds...)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{399.0, 296.0, 305.0}, a.Stakes)
+	assert.InDelta(t, 36.0, a.Profit, 1e-9)
+
+	a, ok = ArbitrageN(1000.0, 50.0, odds...)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{400.0, 300.0, 300.0}, a.Stakes)
+	assert.InDelta(t, 20.0, a.Profit, 1e-9)
+
+	// Stakes of 5 on every outcome would overspend the total of 10.
+	a, ok = ArbitrageN(10.0, 5.0, odds...)
+	assert.False(t, ok)
+	assert.Equal(t, []float64{0.0, 0.0, 0.0}, a.Stakes)
+
+	// Rounding each stake to the nearest, 27, 20 and 20, would overspend 66.5.
+	a, ok = ArbitrageN(66.5, 1.0, odds...)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{26.0, 20.0, 20.0}, a.Stakes)
+	assert.InDelta(t, 1.6, a.Profit, 1e-9)
+}
+
+func TestSyntheticHold(t *testing.T) {
+	home := map[string]Odds{
+		"bookA": NewOddsFromAmerican(-110.0),
+		"bookB": NewOddsFromAmerican(-105.0),
+		"bookC": NewOddsFromAmerican(-115.0),
+	}
+	away := map[string]Odds{
+		"bookA": NewOddsFromAmerican(-110.0),
+		"bookB": NewOddsFromAmerican(-115.0),
+		"bookC": NewOddsFromAmerican(+100.0),
+	}
+	h, books := SyntheticHold(home, away)
+	assert.Equal(t, []string{"bookB", "bookC"}, books)
+	assert.InDelta(t, 0.0120, h, 0.0001)
+
+	away["bookD"] = NewOddsFromAmerican(+110.0)
+	h, books = SyntheticHold(home, away)
+	assert.Equal(t, []string{"bookB", "bookD"}, books)
+	assert.InDelta(t, -0.0118, h, 0.0001)
+
+	assert.InDelta(t, 0.04545, hold(NewOddsFromAmerican(-110.0), NewOddsFromAmerican(-110.0)), 0.00001)
+}
+
+func TestBestOdds(t *testing.T) {
+	book, odds := BestOdds(map[string]Odds{
+		"bookA": NewOddsFromAmerican(-110.0),
+		"bookB": NewOddsFromAmerican(-105.0),
+		"bookC": NewOddsFromAmerican(-105.0),
+	})
+	assert.Equal(t, "bookB", book)
+	assert.Equal(t, -105.0, odds.American())
+
+	book, _ = BestOdds(map[string]Odds{})
+	assert.Equal(t, "", book)
+}
+
+func TestBestLine(t *testing.T) {
+	home := map[string]Odds{"bookA": NewOddsFromDecimal(2.0), "bookB": NewOddsFromDecimal(2.1)}
+	draw := map[string]Odds{"bookA": NewOddsFromDecimal(3.4), "bookB": NewOddsFromDecimal(3.3)}
+	away := map[string]Odds{"bookA": NewOddsFromDecimal(4.0), "bookC": NewOddsFromDecimal(4.2)}
+	books, odds := BestLine(home, draw, away)
+	assert.Equal(t, []string{"bookB", "bookA", "bookC"}, books)
+	assert.Equal(t, []Odds{NewOddsFromDecimal(2.1), NewOddsFromDecimal(3.4), NewOddsFromDecimal(4.2)}, odds)
+}
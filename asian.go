@@ -0,0 +1,101 @@
+package wagering
+
+import (
+	"math"
+)
+
+// AsianHandicap is an Asian handicap wager. Quarter lines, such as -0.25 or -0.75,
+// are made up of two component lines either side of the quoted line, with half the
+// stake on each.
+type AsianHandicap struct {
+	line  float64
+	odds  Odds
+	stake float64
+}
+
+// NewAsianHandicap constructs a new AsianHandicap of stake at odds on the given
+// line, the handicap applied to the selected team's score.
+func NewAsianHandicap(line float64, odds Odds, stake float64) AsianHandicap {
+	return AsianHandicap{line: line, odds: odds, stake: stake}
+}
+
+// Line returns the quoted handicap line.
+func (ah AsianHandicap) Line() float64 {
+	return ah.line
+}
+
+// Odds returns the odds of the wager.
+func (ah AsianHandicap) Odds() Odds {
+	return ah.odds
+}
+
+// Stake returns the total amount wagered.
+func (ah AsianHandicap) Stake() float64 {
+	return ah.stake
+}
+
+// IsQuarter returns whether the line is a quarter line split across two lines.
+func (ah AsianHandicap) IsQuarter() bool {
+	return math.Mod(math.Abs(ah.line*4.0), 2.0) == 1.0
+}
+
+// Lines returns the component lines the stake is split across, two for a quarter
+// line and one otherwise.
+func (ah AsianHandicap) Lines() []float64 {
+	if ah.IsQuarter() {
+		return []float64{ah.line - 0.25, ah.line + 0.25}
+	}
+	return []float64{ah.line}
+}
+
+// Stakes returns the stake on each of the component lines.
+func (ah AsianHandicap) Stakes() []float64 {
+	lines := ah.Lines()
+	var stakes []float64
+	for range lines {
+		stakes = append(stakes, ah.stake/float64(len(lines)))
+	}
+	return stakes
+}
+
+// lineResult returns the result of a single handicap line for the given margin.
+func lineResult(line, margin float64) Result {
+	adjusted := margin + line
+	if adjusted > 0.0 {
+		return Win
+	} else if adjusted < 0.0 {
+		return Loss
+	}
+	return Push
+}
+
+// Result returns the result of the wager when the selected team finishes with the
+// given margin, its score minus the opponent's, combining the results of the
+// component lines into a HalfWin or HalfLoss where they differ.
+func (ah AsianHandicap) Result(margin float64) Result {
+	lines := ah.Lines()
+	first := lineResult(lines[0], margin)
+	last := lineResult(lines[len(lines)-1], margin)
+	switch {
+	case first == last:
+		return first
+	case first == Push && last == Win:
+		return HalfWin
+	case first == Loss && last == Push:
+		return HalfLoss
+	}
+	// Component lines are only half a goal apart so cannot both be decided.
+	return first
+}
+
+// Grade returns the result of the wager and its total return, including stake,
+// when the selected team finishes with the given margin, its score minus the
+// opponent's.
+func (ah AsianHandicap) Grade(margin float64) (Result, float64) {
+	payout := 0.0
+	stakes := ah.Stakes()
+	for i, line := range ah.Lines() {
+		payout += stakes[i] * settledOdds(ah.odds, lineResult(line, margin))
+	}
+	return ah.Result(margin), payout
+}
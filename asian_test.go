@@ -0,0 +1,65 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAsianHandicap_Lines(t *testing.T) {
+	odds := NewOddsFromDecimal(1.9)
+	assert.Equal(t, []float64{-0.5, 0.0}, NewAsianHandicap(-0.25, odds, 100.0).Lines())
+	assert.Equal(t, []float64{-1.0, -0.5}, NewAsianHandicap(-0.75, odds, 100.0).Lines())
+	assert.Equal(t, []float64{1.0, 1.5}, NewAsianHandicap(1.25, odds, 100.0).Lines())
+	assert.Equal(t, []float64{-1.5}, NewAsianHandicap(-1.5, odds, 100.0).Lines())
+	assert.Equal(t, []float64{0.0}, NewAsianHandicap(0.0, odds, 100.0).Lines())
+
+	assert.Equal(t, []float64{50.0, 50.0}, NewAsianHandicap(-0.75, odds, 100.0).Stakes())
+	assert.Equal(t, []float64{100.0}, NewAsianHandicap(-1.0, odds, 100.0).Stakes())
+}
+
+func TestAsianHandicap_Result(t *testing.T) {
+	var expectedResults = []struct {
+		line   float64
+		margin float64
+		result Result
+	}{
+		{-0.25, 1.0, Win},
+		{-0.25, 0.0, HalfLoss},
+		{-0.25, -1.0, Loss},
+		{+0.25, 0.0, HalfWin},
+		{-0.75, 1.0, HalfWin},
+		{-0.75, 2.0, Win},
+		{-0.75, 0.0, Loss},
+		{-1.0, 1.0, Push},
+		{-1.25, 1.0, HalfLoss},
+		{+1.75, -2.0, HalfLoss},
+		{-1.5, 1.0, Loss},
+	}
+	odds := NewOddsFromDecimal(1.9)
+	for _, er := range expectedResults {
+		ah := NewAsianHandicap(er.line, odds, 100.0)
+		assert.Equal(t, er.result, ah.Result(er.margin), "line %v with margin %v", er.line, er.margin)
+	}
+}
+
+func TestAsianHandicap_Grade(t *testing.T) {
+	var expectedGrades = []struct {
+		line   float64
+		margin float64
+		result Result
+		payout float64
+	}{
+		{-0.25, 1.0, Win, 190.0},
+		{-0.25, 0.0, HalfLoss, 50.0},
+		{+0.25, 0.0, HalfWin, 145.0},
+		{-0.75, 1.0, HalfWin, 145.0},
+		{-1.0, 1.0, Push, 100.0},
+		{-1.5, 1.0, Loss, 0.0},
+	}
+	odds := NewOddsFromDecimal(1.9)
+	for _, eg := range expectedGrades {
+		result, payout := NewAsianHandicap(eg.line, odds, 100.0).Grade(eg.margin)
+		assert.Equal(t, eg.result, result, "line %v with margin %v", eg.line, eg.margin)
+		assert.InDelta(t, eg.payout, payout, 1e-9, "line %v with margin %v", eg.line, eg.margin)
+	}
+}
@@ -0,0 +1,259 @@
+package wagering
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Accumulator accumulates samples of type T, such as Odds or WeightedOdds, into an
+// average Odds. Consensus building code written against an Accumulator can swap
+// aggregation strategies, such as AverageOdds, WeightedAverageOdds, EWMAOdds or
+// MedianAccumulator, without changing its call sites.
+type Accumulator[T any] interface {
+	// Add accumulates a sample.
+	Add(sample T)
+	// Average returns the average Odds of the samples, or the zero Odds if none
+	// have been accumulated.
+	Average() Odds
+}
+
+// WeightedOdds is odds carrying a weight, the sample of a WeightedAverageOdds.
+type WeightedOdds struct {
+	Odds   Odds
+	Weight float64
+}
+
+// TWAP returns the time weighted average of the decimal odds of the series from its
+// first point until end, each odds weighted by how long it was quoted, until the
+// next point or end. Points at or after end are ignored. The zero Odds is returned
+// if no odds were quoted for a positive duration before end.
+func (s *OddsSeries) TWAP(end time.Time) Odds {
+	sum := 0.0
+	total := 0.0
+	for i, p := range s.points {
+		if !p.Time.Before(end) {
+			break
+		}
+		until := end
+		if i+1 < len(s.points) && s.points[i+1].Time.Before(end) {
+			until = s.points[i+1].Time
+		}
+		weight := until.Sub(p.Time).Seconds()
+		sum += weight * p.Odds.decimalOdds
+		total += weight
+	}
+	if total == 0.0 {
+		return Odds{}
+	}
+	return NewOddsFromDecimal(sum / total)
+}
+
+// EWMAOdds computes the exponentially weighted moving average of odds over time,
+// weighting recent odds more heavily than stale ones.
+type EWMAOdds struct {
+	halfLife time.Duration
+	// sum and weight are the decayed weighted sum of the decimal odds and the
+	// decayed total weight as of last.
+	sum     float64
+	weight  float64
+	last    time.Time
+	started bool
+}
+
+// NewEWMAOdds constructs a new EWMAOdds where the weight of odds halves every
+// halfLife.
+func NewEWMAOdds(halfLife time.Duration) EWMAOdds {
+	return EWMAOdds{halfLife: halfLife}
+}
+
+// Accumulate accumulates the odds quoted at time t. Each odds has a weight of one
+// when quoted that halves every half life, so odds quoted at the same time are
+// averaged equally. Odds should be accumulated in time order; odds quoted before
+// the last accumulated are weighted as if quoted at the same time.
+func (e *EWMAOdds) Accumulate(t time.Time, odds Odds) {
+	if !e.started {
+		e.last = t
+		e.started = true
+	} else if elapsed := t.Sub(e.last); elapsed > 0 {
+		decay := math.Pow(0.5, elapsed.Seconds()/e.halfLife.Seconds())
+		e.sum *= decay
+		e.weight *= decay
+		e.last = t
+	}
+	e.sum += odds.decimalOdds
+	e.weight++
+}
+
+// Add accumulates the odds of point quoted at its time, implementing Accumulator.
+func (e *EWMAOdds) Add(point OddsPoint) {
+	e.Accumulate(point.Time, point.Odds)
+}
+
+// Average returns the exponentially weighted moving average odds, or the zero
+// Odds if none have been accumulated.
+func (e *EWMAOdds) Average() Odds {
+	if !e.started {
+		return Odds{}
+	}
+	return NewOddsFromDecimal(e.sum / e.weight)
+}
+
+// SyncAverageOdds is an AverageOdds that is safe for concurrent use, such as by
+// several feed goroutines accumulating into the same average.
+type SyncAverageOdds struct {
+	mu      sync.Mutex
+	average AverageOdds
+}
+
+// NewSyncAverageOdds constructs a new SyncAverageOdds.
+func NewSyncAverageOdds() *SyncAverageOdds {
+	return &SyncAverageOdds{}
+}
+
+// Accumulate accumulates Odds into the average.
+func (sao *SyncAverageOdds) Accumulate(odds ...Odds) {
+	sao.mu.Lock()
+	defer sao.mu.Unlock()
+	sao.average.Accumulate(odds...)
+}
+
+// Add accumulates odds into the average, implementing Accumulator.
+func (sao *SyncAverageOdds) Add(odds Odds) {
+	sao.Accumulate(odds)
+}
+
+// Count returns the number of Odds accumulated.
+func (sao *SyncAverageOdds) Count() int {
+	sao.mu.Lock()
+	defer sao.mu.Unlock()
+	return sao.average.Count()
+}
+
+// Average returns the average Odds, or the zero Odds if none have been
+// accumulated.
+func (sao *SyncAverageOdds) Average() Odds {
+	sao.mu.Lock()
+	defer sao.mu.Unlock()
+	return sao.average.Average()
+}
+
+// AverageOk returns the average Odds and whether any have been accumulated.
+func (sao *SyncAverageOdds) AverageOk() (Odds, bool) {
+	sao.mu.Lock()
+	defer sao.mu.Unlock()
+	return sao.average.AverageOk()
+}
+
+// AverageWithout returns the average Odds with a count of odds removed. See
+// AverageOdds.AverageWithout.
+func (sao *SyncAverageOdds) AverageWithout(odds Odds, count int) Odds {
+	sao.mu.Lock()
+	defer sao.mu.Unlock()
+	return sao.average.AverageWithout(odds, count)
+}
+
+// WeightedAverageOdds computes the weighted average of a number of Odds, where each
+// carries a weight such as the sharpness, liquidity or recency of its book.
+type WeightedAverageOdds struct {
+	sum    float64
+	weight float64
+}
+
+// NewWeightedAverageOdds constructs a new WeightedAverageOdds.
+func NewWeightedAverageOdds() WeightedAverageOdds {
+	return WeightedAverageOdds{}
+}
+
+// Accumulate accumulates odds with the given weight.
+func (wao *WeightedAverageOdds) Accumulate(odds Odds, weight float64) {
+	wao.sum += weight * odds.decimalOdds
+	wao.weight += weight
+}
+
+// Add accumulates the odds of sample with its weight, implementing Accumulator.
+func (wao *WeightedAverageOdds) Add(sample WeightedOdds) {
+	wao.Accumulate(sample.Odds, sample.Weight)
+}
+
+// Weight returns the total weight accumulated.
+func (wao *WeightedAverageOdds) Weight() float64 {
+	return wao.weight
+}
+
+// Average returns the weighted average Odds, or the zero Odds if the total weight
+// is not positive.
+func (wao *WeightedAverageOdds) Average() Odds {
+	odds, _ := wao.AverageOk()
+	return odds
+}
+
+// AverageOk returns the weighted average Odds and whether the total weight is
+// positive, the zero Odds being returned if not.
+func (wao *WeightedAverageOdds) AverageOk() (Odds, bool) {
+	if wao.weight <= 0.0 {
+		return Odds{}, false
+	}
+	return NewOddsFromDecimal(wao.sum / wao.weight), true
+}
+
+// decimals returns the decimal odds of each of the given odds.
+func decimals(odds []Odds) []float64 {
+	var values []float64
+	for _, o := range odds {
+		values = append(values, o.decimalOdds)
+	}
+	return values
+}
+
+// MedianOdds returns the median of the decimal odds of the given odds, which unlike
+// the mean is not distorted by a single off market book, or the zero Odds if none
+// are given.
+func MedianOdds(odds ...Odds) Odds {
+	if len(odds) == 0 {
+		return Odds{}
+	}
+	return NewOddsFromDecimal(quantile(sorted(decimals(odds)), 0.5))
+}
+
+// MedianAccumulator tracks the median of a number of Odds, see MedianOdds.
+type MedianAccumulator struct {
+	odds []Odds
+}
+
+// NewMedianAccumulator constructs a new MedianAccumulator.
+func NewMedianAccumulator() MedianAccumulator {
+	return MedianAccumulator{}
+}
+
+// Accumulate accumulates Odds into the median.
+func (ma *MedianAccumulator) Accumulate(odds ...Odds) {
+	ma.odds = append(ma.odds, odds...)
+}
+
+// Add accumulates odds into the median, implementing Accumulator.
+func (ma *MedianAccumulator) Add(odds Odds) {
+	ma.Accumulate(odds)
+}
+
+// Count returns the number of Odds accumulated.
+func (ma *MedianAccumulator) Count() int {
+	return len(ma.odds)
+}
+
+// Average returns the median Odds, or the zero Odds if none have been accumulated.
+func (ma *MedianAccumulator) Average() Odds {
+	return MedianOdds(ma.odds...)
+}
+
+// TrimmedMeanOdds returns the mean of the decimal odds of the given odds after
+// discarding pct percent, 0 to 50, of the odds from each end, rounded down to a
+// whole number of odds. The zero Odds is returned if none remain.
+func TrimmedMeanOdds(pct float64, odds ...Odds) Odds {
+	values := sorted(decimals(odds))
+	trim := int(math.Floor(float64(len(values)) * pct / 100.0))
+	if len(values)-2*trim <= 0 {
+		return Odds{}
+	}
+	return NewOddsFromDecimal(mean(values[trim : len(values)-trim]))
+}
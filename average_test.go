@@ -0,0 +1,131 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOddsSeries_TWAP(t *testing.T) {
+	start := time.Date(2024, 9, 8, 9, 0, 0, 0, time.UTC)
+	series := NewOddsSeries(
+		OddsPoint{start, NewOddsFromDecimal(2.0)},
+		OddsPoint{start.Add(3 * time.Hour), NewOddsFromDecimal(3.0)},
+		OddsPoint{start.Add(4 * time.Hour), NewOddsFromDecimal(4.0)},
+	)
+	// 2.0 for three hours and 3.0 for one.
+	assert.InDelta(t, 2.25, series.TWAP(start.Add(4*time.Hour)).Decimal(), 1e-12)
+	assert.InDelta(t, 2.6, series.TWAP(start.Add(5*time.Hour)).Decimal(), 1e-12)
+	assert.InDelta(t, 2.0, series.TWAP(start.Add(time.Hour)).Decimal(), 1e-12)
+	assert.Equal(t, Odds{}, series.TWAP(start))
+}
+
+func TestEWMAOdds(t *testing.T) {
+	start := time.Date(2024, 9, 8, 9, 0, 0, 0, time.UTC)
+	e := NewEWMAOdds(time.Hour)
+	assert.Equal(t, Odds{}, e.Average())
+
+	e.Accumulate(start, NewOddsFromDecimal(2.0))
+	assert.Equal(t, 2.0, e.Average().Decimal())
+	// The first odds has half the weight of the second a half life later.
+	e.Accumulate(start.Add(time.Hour), NewOddsFromDecimal(3.0))
+	assert.InDelta(t, 8.0/3.0, e.Average().Decimal(), 1e-12)
+	e.Accumulate(start.Add(3*time.Hour), NewOddsFromDecimal(3.0))
+	assert.InDelta(t, 4.0/1.375, e.Average().Decimal(), 1e-12)
+
+	// Odds quoted at the same time are averaged equally, in any order, and much as
+	// odds quoted a moment apart.
+	simultaneous := func(first, second float64, gap time.Duration) float64 {
+		e := NewEWMAOdds(time.Hour)
+		e.Accumulate(start, NewOddsFromDecimal(first))
+		e.Accumulate(start.Add(gap), NewOddsFromDecimal(second))
+		return e.Average().Decimal()
+	}
+	assert.InDelta(t, 2.5, simultaneous(2.0, 3.0, 0), 1e-12)
+	assert.InDelta(t, 2.5, simultaneous(3.0, 2.0, 0), 1e-12)
+	assert.InDelta(t, 2.5, simultaneous(2.0, 3.0, time.Nanosecond), 1e-9)
+
+	// Odds quoted out of order are weighted as if quoted at the last time.
+	assert.InDelta(t, 2.5, simultaneous(2.0, 3.0, -time.Hour), 1e-12)
+}
+
+func TestSyncAverageOdds(t *testing.T) {
+	sao := NewSyncAverageOdds()
+	_, ok := sao.AverageOk()
+	assert.False(t, ok)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				sao.Accumulate(NewOddsFromDecimal(2.0), NewOddsFromDecimal(4.0))
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2000, sao.Count())
+	assert.InDelta(t, 3.0, sao.Average().Decimal(), 1e-12)
+	assert.InDelta(t, 2.0, sao.AverageWithout(NewOddsFromDecimal(4.0), 1000).Decimal(), 1e-12)
+}
+
+func TestWeightedAverageOdds(t *testing.T) {
+	wao := NewWeightedAverageOdds()
+	_, ok := wao.AverageOk()
+	assert.False(t, ok)
+	assert.Equal(t, Odds{}, wao.Average())
+
+	wao.Accumulate(NewOddsFromDecimal(2.0), 3.0)
+	wao.Accumulate(NewOddsFromDecimal(4.0), 1.0)
+	assert.Equal(t, 4.0, wao.Weight())
+	assert.InDelta(t, 2.5, wao.Average().Decimal(), 1e-12)
+}
+
+func TestMedianOdds(t *testing.T) {
+	assert.Equal(t, 2.0, MedianOdds(NewOddsFromDecimal(2.1), NewOddsFromDecimal(1.9), NewOddsFromDecimal(2.0)).Decimal())
+	assert.Equal(t, 2.05, MedianOdds(NewOddsFromDecimal(2.1), NewOddsFromDecimal(5.0), NewOddsFromDecimal(2.0), NewOddsFromDecimal(1.9)).Decimal())
+	assert.Equal(t, Odds{}, MedianOdds())
+}
+
+func TestTrimmedMeanOdds(t *testing.T) {
+	odds := []Odds{
+		NewOddsFromDecimal(1.9), NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.1),
+		NewOddsFromDecimal(2.0), NewOddsFromDecimal(9.0),
+	}
+	assert.InDelta(t, 2.0333, TrimmedMeanOdds(20.0, odds...).Decimal(), 0.0001)
+	assert.InDelta(t, 3.4, TrimmedMeanOdds(0.0, odds...).Decimal(), 1e-12)
+	assert.InDelta(t, 3.4, TrimmedMeanOdds(10.0, odds...).Decimal(), 1e-12)
+	assert.Equal(t, Odds{}, TrimmedMeanOdds(50.0, odds[:2]...))
+}
+
+// consensus returns the average of odds accumulated by acc.
+func consensus(acc Accumulator[Odds], odds ...Odds) Odds {
+	for _, o := range odds {
+		acc.Add(o)
+	}
+	return acc.Average()
+}
+
+func TestAccumulator(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(1.9), NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.6)}
+	avg := NewAverageOdds()
+	assert.InDelta(t, 2.1667, consensus(&avg, odds...).decimalOdds, 0.0001)
+	assert.InDelta(t, 2.1667, consensus(NewSyncAverageOdds(), odds...).decimalOdds, 0.0001)
+	median := NewMedianAccumulator()
+	assert.Equal(t, 2.0, consensus(&median, odds...).decimalOdds)
+	assert.Equal(t, 3, median.Count())
+	assert.Equal(t, Odds{}, consensus(&MedianAccumulator{}))
+
+	var weighted Accumulator[WeightedOdds] = &WeightedAverageOdds{}
+	weighted.Add(WeightedOdds{NewOddsFromDecimal(2.0), 3.0})
+	weighted.Add(WeightedOdds{NewOddsFromDecimal(3.0), 1.0})
+	assert.InDelta(t, 2.25, weighted.Average().decimalOdds, 1e-9)
+
+	start := time.Date(2024, 9, 8, 9, 0, 0, 0, time.UTC)
+	ewma := NewEWMAOdds(time.Hour)
+	var points Accumulator[OddsPoint] = &ewma
+	points.Add(OddsPoint{start, NewOddsFromDecimal(2.0)})
+	assert.InDelta(t, 2.0, points.Average().decimalOdds, 1e-9)
+}
@@ -0,0 +1,153 @@
+package wagering
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrResultCount is returned when settling a bet with a result count other than
+// its number of selections.
+var ErrResultCount = errors.New("wrong number of results")
+
+// checkResults returns an error wrapping ErrResultCount unless there are n results.
+func checkResults(results []Result, n int) error {
+	if len(results) != n {
+		return fmt.Errorf("%w: expected %d, got %d", ErrResultCount, n, len(results))
+	}
+	return nil
+}
+
+// Bet is a wager that can be settled from the results of its selections, allowing
+// slips of different kinds of bets to be graded uniformly.
+type Bet interface {
+	// Stake returns the amount wagered.
+	Stake() float64
+	// Odds returns the odds the bet pays if it wins.
+	Odds() Odds
+	// Settle returns the result and total return, including stake, of the bet given
+	// the results of each of its selections, in order. An error wrapping
+	// ErrResultCount is returned if there is not one result per selection.
+	Settle(results ...Result) (Result, float64, error)
+}
+
+// payoutResult returns the overall result of a bet of stake that returned payout.
+func payoutResult(stake, payout float64) Result {
+	switch {
+	case payout > stake:
+		return Win
+	case payout == stake:
+		return Push
+	case payout == 0.0:
+		return Loss
+	default:
+		return HalfLoss
+	}
+}
+
+// Straight is a single wager on a single selection.
+type Straight struct {
+	odds  Odds
+	stake float64
+}
+
+// NewStraight constructs a new Straight wagering stake at odds.
+func NewStraight(odds Odds, stake float64) Straight {
+	return Straight{odds: odds, stake: stake}
+}
+
+// Stake returns the amount wagered.
+func (s Straight) Stake() float64 {
+	return s.stake
+}
+
+// Odds returns the odds of the wager.
+func (s Straight) Odds() Odds {
+	return s.odds
+}
+
+// Settle returns the result and total return, including stake, of the wager given
+// the result of its selection.
+func (s Straight) Settle(results ...Result) (Result, float64, error) {
+	if err := checkResults(results, 1); err != nil {
+		return Loss, 0.0, err
+	}
+	return results[0], s.stake * settledOdds(s.odds, results[0]), nil
+}
+
+// Parlay is a single wager on multiple selections that wins only if every
+// selection wins. Pushed selections are settled at odds of 1.0.
+type Parlay struct {
+	legs  []Odds
+	stake float64
+}
+
+// NewParlay constructs a new Parlay wagering stake on legs at the given odds.
+func NewParlay(stake float64, legs ...Odds) Parlay {
+	return Parlay{legs: legs, stake: stake}
+}
+
+// Stake returns the amount wagered.
+func (p Parlay) Stake() float64 {
+	return p.stake
+}
+
+// Odds returns the combined odds of the parlay.
+func (p Parlay) Odds() Odds {
+	return ParlayOdds(p.legs...)
+}
+
+// Legs returns the odds of each leg of the parlay.
+func (p Parlay) Legs() []Odds {
+	return p.legs
+}
+
+// Settle returns the result and total return, including stake, of the parlay given
+// the results of each of its legs.
+func (p Parlay) Settle(results ...Result) (Result, float64, error) {
+	if err := checkResults(results, len(p.legs)); err != nil {
+		return Loss, 0.0, err
+	}
+	payout := p.stake
+	for i, leg := range p.legs {
+		payout *= settledOdds(leg, results[i])
+	}
+	return payoutResult(p.stake, payout), payout, nil
+}
+
+// Futures is a long term wager on a single selection, such as a team to win a
+// championship.
+type Futures struct {
+	selection string
+	odds      Odds
+	stake     float64
+}
+
+// NewFutures constructs a new Futures wagering stake at odds on the named
+// selection.
+func NewFutures(selection string, odds Odds, stake float64) Futures {
+	return Futures{selection: selection, odds: odds, stake: stake}
+}
+
+// Selection returns the name of the selection.
+func (f Futures) Selection() string {
+	return f.selection
+}
+
+// Stake returns the amount wagered.
+func (f Futures) Stake() float64 {
+	return f.stake
+}
+
+// Odds returns the odds of the wager.
+func (f Futures) Odds() Odds {
+	return f.odds
+}
+
+// Settle returns the result and total return, including stake, of the wager given
+// the result of its selection.
+func (f Futures) Settle(results ...Result) (Result, float64, error) {
+	if err := checkResults(results, 1); err != nil {
+		return Loss, 0.0, err
+	}
+	return results[0], f.stake * settledOdds(f.odds, results[0]), nil
+}
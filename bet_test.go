@@ -0,0 +1,58 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBet_Settle(t *testing.T) {
+	var expectedSettlements = []struct {
+		bet     Bet
+		results []Result
+		result  Result
+		payout  float64
+	}{
+		{NewStraight(NewOddsFromDecimal(2.5), 10.0), []Result{Win}, Win, 25.0},
+		{NewStraight(NewOddsFromDecimal(2.5), 10.0), []Result{Loss}, Loss, 0.0},
+		{NewStraight(NewOddsFromDecimal(2.5), 10.0), []Result{Push}, Push, 10.0},
+		{NewStraight(NewOddsFromDecimal(2.5), 10.0), []Result{HalfWin}, HalfWin, 17.5},
+		{NewParlay(10.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)), []Result{Win, Win}, Win, 60.0},
+		{NewParlay(10.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)), []Result{Win, Push}, Win, 20.0},
+		{NewParlay(10.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)), []Result{Push, Push}, Push, 10.0},
+		{NewParlay(10.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)), []Result{Win, Loss}, Loss, 0.0},
+		{standardTeaser(TeaserLeg{}, TeaserLeg{}).WithStake(12.0), []Result{Win, Win}, Win, 22.0},
+		{standardTeaser(TeaserLeg{}, TeaserLeg{}).WithStake(12.0), []Result{Win, Push}, Loss, 0.0},
+		{NewFutures("Chiefs", NewOddsFromAmerican(+600.0), 10.0), []Result{Win}, Win, 70.0},
+		{NewFutures("Chiefs", NewOddsFromAmerican(+600.0), 10.0), []Result{Loss}, Loss, 0.0},
+	}
+	for _, es := range expectedSettlements {
+		result, payout, err := es.bet.Settle(es.results...)
+		assert.NoError(t, err)
+		assert.Equal(t, es.result, result, "settling %T with %v", es.bet, es.results)
+		assert.InDelta(t, es.payout, payout, 1e-9, "settling %T with %v", es.bet, es.results)
+	}
+}
+
+func TestBet_SettleResultCount(t *testing.T) {
+	bets := []Bet{
+		NewStraight(NewOddsFromDecimal(2.5), 10.0),
+		NewFutures("Chiefs", NewOddsFromAmerican(+600.0), 10.0),
+		NewParlay(10.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)),
+		standardTeaser(TeaserLeg{}, TeaserLeg{}, TeaserLeg{}).WithStake(10.0),
+	}
+	for _, bet := range bets {
+		_, _, err := bet.Settle()
+		assert.ErrorIs(t, err, ErrResultCount, "settling %T without results", bet)
+		_, _, err = bet.Settle(Win, Win)
+		if _, ok := bet.(Parlay); !ok {
+			assert.ErrorIs(t, err, ErrResultCount, "settling %T with two results", bet)
+		}
+	}
+}
+
+func TestParlay_Odds(t *testing.T) {
+	parlay := NewParlay(10.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0))
+	assert.Equal(t, 6.0, parlay.Odds().decimalOdds)
+	assert.Equal(t, 10.0, parlay.Stake())
+	assert.Len(t, parlay.Legs(), 2)
+}
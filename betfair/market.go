@@ -0,0 +1,144 @@
+// Package betfair adapts Betfair exchange price ladders to wagering types, pricing
+// the odds actually executable for a stake after commission.
+package betfair
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/dburger/wagering"
+)
+
+// PriceSize is an amount available at a price.
+type PriceSize struct {
+	Price float64 `json:"price"`
+	Size  float64 `json:"size"`
+}
+
+// Runner is a selection of a market and its price ladder.
+type Runner struct {
+	SelectionID int64
+	Name        string
+	// Back is the amount available to back at each price, best, longest, first.
+	Back []PriceSize
+	// Lay is the amount of backer's stake available to lay at each price, best,
+	// shortest, first.
+	Lay []PriceSize
+}
+
+// fill returns the average price of matching stake against ladder in order and the
+// amount matched, less than stake if the ladder has insufficient size.
+func fill(ladder []PriceSize, stake float64) (wagering.Odds, float64) {
+	matched, returned := 0.0, 0.0
+	for _, ps := range ladder {
+		if matched >= stake {
+			break
+		}
+		s := min(ps.Size, stake-matched)
+		matched += s
+		returned += s * ps.Price
+	}
+	if matched == 0.0 {
+		return wagering.Odds{}, 0.0
+	}
+	return wagering.NewOddsFromDecimal(returned / matched), matched
+}
+
+// BackPrice returns the average odds of backing stake by taking the available
+// prices in order, and the amount matched, less than stake if there is insufficient
+// size. The zero Odds is returned if nothing is available.
+func (r Runner) BackPrice(stake float64) (wagering.Odds, float64) {
+	return fill(r.Back, stake)
+}
+
+// LayPrice returns the average odds of laying a backer's stake by taking the
+// available prices in order, and the amount matched, less than stake if there is
+// insufficient size. The zero Odds is returned if nothing is available.
+func (r Runner) LayPrice(stake float64) (wagering.Odds, float64) {
+	return fill(r.Lay, stake)
+}
+
+// EffectiveBackOdds returns the odds of backing stake, as in BackPrice, net of the
+// given commission on winnings. See wagering.Odds.NetOfCommission.
+func (r Runner) EffectiveBackOdds(stake, commission float64) wagering.Odds {
+	odds, matched := r.BackPrice(stake)
+	if matched == 0.0 {
+		return wagering.Odds{}
+	}
+	return odds.NetOfCommission(commission)
+}
+
+// EffectiveLayOdds returns the odds of laying a backer's stake, as in LayPrice,
+// viewed as a back bet on the selection losing after the given commission on
+// winnings. See wagering.Odds.LayOdds.
+func (r Runner) EffectiveLayOdds(stake, commission float64) wagering.Odds {
+	odds, matched := r.LayPrice(stake)
+	if matched == 0.0 {
+		return wagering.Odds{}
+	}
+	return odds.LayOdds(commission)
+}
+
+// MarketBook is the price ladders of the runners of a market.
+type MarketBook struct {
+	MarketID string
+	Runners  []Runner
+}
+
+// BackMarket returns the market of the best back price of each runner net of the
+// given commission, with Name the market ID, Book "betfair" and outcomes named by
+// runner name, or selection ID if unnamed. Runners with nothing available to back
+// are omitted.
+func (mb MarketBook) BackMarket(commission float64) wagering.Market {
+	m := wagering.Market{Name: mb.MarketID, Book: "betfair"}
+	for _, r := range mb.Runners {
+		if len(r.Back) == 0 {
+			continue
+		}
+		name := r.Name
+		if name == "" {
+			name = strconv.FormatInt(r.SelectionID, 10)
+		}
+		odds := wagering.NewOddsFromDecimal(r.Back[0].Price).NetOfCommission(commission)
+		m.Outcomes = append(m.Outcomes, wagering.Outcome{Name: name, Odds: odds})
+	}
+	return m
+}
+
+// apiMarketBook is the JSON representation of a market book of the listMarketBook
+// operation of the Betting API.
+type apiMarketBook struct {
+	MarketID string `json:"marketId"`
+	Runners  []struct {
+		SelectionID int64 `json:"selectionId"`
+		Ex          struct {
+			AvailableToBack []PriceSize `json:"availableToBack"`
+			AvailableToLay  []PriceSize `json:"availableToLay"`
+		} `json:"ex"`
+	} `json:"runners"`
+}
+
+// ParseMarketBooks parses the JSON result of the listMarketBook operation, naming
+// runners by names, a map from selection ID to runner name as given by the market
+// catalogue, which may be nil.
+func ParseMarketBooks(r io.Reader, names map[int64]string) ([]MarketBook, error) {
+	var apiBooks []apiMarketBook
+	if err := json.NewDecoder(r).Decode(&apiBooks); err != nil {
+		return nil, err
+	}
+	var books []MarketBook
+	for _, ab := range apiBooks {
+		mb := MarketBook{MarketID: ab.MarketID}
+		for _, ar := range ab.Runners {
+			mb.Runners = append(mb.Runners, Runner{
+				SelectionID: ar.SelectionID,
+				Name:        names[ar.SelectionID],
+				Back:        ar.Ex.AvailableToBack,
+				Lay:         ar.Ex.AvailableToLay,
+			})
+		}
+		books = append(books, mb)
+	}
+	return books, nil
+}
@@ -0,0 +1,73 @@
+package betfair
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dburger/wagering"
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleMarketBooks = `[{
+	"marketId": "1.229385621",
+	"runners": [{
+		"selectionId": 47972,
+		"ex": {
+			"availableToBack": [{"price": 2.5, "size": 100}, {"price": 2.48, "size": 300}],
+			"availableToLay": [{"price": 2.52, "size": 50}, {"price": 2.54, "size": 500}]
+		}
+	}, {
+		"selectionId": 47973,
+		"ex": {"availableToBack": [{"price": 1.66, "size": 800}], "availableToLay": []}
+	}, {
+		"selectionId": 58805,
+		"ex": {"availableToBack": [], "availableToLay": [{"price": 10.0, "size": 20}]}
+	}]
+}]`
+
+func TestParseMarketBooks(t *testing.T) {
+	books, err := ParseMarketBooks(strings.NewReader(sampleMarketBooks), map[int64]string{47972: "Arsenal"})
+	assert.NoError(t, err)
+	assert.Len(t, books, 1)
+	mb := books[0]
+	assert.Equal(t, "1.229385621", mb.MarketID)
+	assert.Len(t, mb.Runners, 3)
+	assert.Equal(t, "Arsenal", mb.Runners[0].Name)
+	assert.Equal(t, []PriceSize{{2.52, 50}, {2.54, 500}}, mb.Runners[0].Lay)
+
+	m := mb.BackMarket(0.02)
+	assert.Equal(t, "betfair", m.Book)
+	assert.Len(t, m.Outcomes, 2)
+	assert.Equal(t, "Arsenal", m.Outcomes[0].Name)
+	assert.InDelta(t, 2.47, m.Outcomes[0].Odds.Decimal(), 1e-9)
+	assert.Equal(t, "47973", m.Outcomes[1].Name)
+
+	_, err = ParseMarketBooks(strings.NewReader("["), nil)
+	assert.Error(t, err)
+}
+
+func TestRunner_Prices(t *testing.T) {
+	books, _ := ParseMarketBooks(strings.NewReader(sampleMarketBooks), nil)
+	r := books[0].Runners[0]
+
+	odds, matched := r.BackPrice(50.0)
+	assert.Equal(t, 50.0, matched)
+	assert.InDelta(t, 2.5, odds.Decimal(), 1e-9)
+	odds, matched = r.BackPrice(200.0)
+	assert.Equal(t, 200.0, matched)
+	assert.InDelta(t, 2.49, odds.Decimal(), 1e-9)
+	_, matched = r.BackPrice(1000.0)
+	assert.Equal(t, 400.0, matched)
+	assert.InDelta(t, 2.4602, r.EffectiveBackOdds(200.0, 0.02).Decimal(), 1e-9)
+
+	odds, matched = r.LayPrice(100.0)
+	assert.Equal(t, 100.0, matched)
+	assert.InDelta(t, 2.53, odds.Decimal(), 1e-9)
+	assert.InDelta(t, wagering.NewOddsFromDecimal(2.53).LayOdds(0.05).Decimal(), r.EffectiveLayOdds(100.0, 0.05).Decimal(), 1e-9)
+
+	r = books[0].Runners[2]
+	odds, matched = r.BackPrice(10.0)
+	assert.Equal(t, wagering.Odds{}, odds)
+	assert.Equal(t, 0.0, matched)
+	assert.Equal(t, wagering.Odds{}, r.EffectiveBackOdds(10.0, 0.02))
+}
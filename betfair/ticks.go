@@ -0,0 +1,101 @@
+package betfair
+
+import (
+	"math"
+	"sort"
+)
+
+// MinPrice and MaxPrice are the shortest and longest prices that can be offered.
+const (
+	MinPrice = 1.01
+	MaxPrice = 1000.0
+)
+
+// tickBand is a range of prices, in hundredths, offered in increments of inc.
+type tickBand struct {
+	low, high, inc int
+}
+
+// tickBands are the bands of the Betfair price ladder.
+var tickBands = []tickBand{
+	{101, 200, 1},
+	{200, 300, 2},
+	{300, 400, 5},
+	{400, 600, 10},
+	{600, 1000, 20},
+	{1000, 2000, 50},
+	{2000, 3000, 100},
+	{3000, 5000, 200},
+	{5000, 10000, 500},
+	{10000, 100000, 1000},
+}
+
+// ticks holds every price of the ladder, in hundredths, in increasing order.
+var ticks = makeTicks()
+
+// makeTicks returns every price of the ladder, in hundredths, in increasing order.
+func makeTicks() []int {
+	var t []int
+	for _, b := range tickBands {
+		for p := b.low; p < b.high; p += b.inc {
+			t = append(t, p)
+		}
+	}
+	return append(t, tickBands[len(tickBands)-1].high)
+}
+
+// hundredths returns price in hundredths.
+func hundredths(price float64) int {
+	return int(math.Round(price * 100.0))
+}
+
+// tickIndex returns the index of the first tick at or above price.
+func tickIndex(price float64) int {
+	h := hundredths(price)
+	return sort.SearchInts(ticks, h)
+}
+
+// ValidPrice returns whether price is on the ladder.
+func ValidPrice(price float64) bool {
+	i := tickIndex(price)
+	return i < len(ticks) && ticks[i] == hundredths(price)
+}
+
+// RoundPrice returns price rounded to the ladder, up to the next longer price if up
+// and down to the next shorter price otherwise, clamped to MinPrice and MaxPrice.
+// A backer asking for at least some price rounds up, a layer rounds down.
+func RoundPrice(price float64, up bool) float64 {
+	i := tickIndex(price)
+	if i == len(ticks) {
+		return MaxPrice
+	}
+	if !up && ticks[i] != hundredths(price) && i > 0 {
+		i--
+	}
+	return float64(ticks[i]) / 100.0
+}
+
+// NextTick returns the price n ticks longer than price, rounded to the ladder, or
+// shorter if n is negative, clamped to MinPrice and MaxPrice.
+func NextTick(price float64, n int) float64 {
+	i := tickIndex(price)
+	if i == len(ticks) || ticks[i] != hundredths(price) {
+		// price lies off the ladder, the adjacent tick in the direction of n is the
+		// first of the n.
+		if n < 0 {
+			i--
+			n++
+		} else if n > 0 {
+			n--
+		}
+	}
+	i += n
+	i = max(0, min(i, len(ticks)-1))
+	return float64(ticks[i]) / 100.0
+}
+
+// TickDistance returns the number of ticks from a to b, both on the ladder,
+// negative if b is shorter than a.
+func TickDistance(a, b float64) int {
+	return tickIndex(b) - tickIndex(a)
+}
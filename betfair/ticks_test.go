@@ -0,0 +1,39 @@
+package betfair
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidPrice(t *testing.T) {
+	assert.True(t, ValidPrice(1.01))
+	assert.True(t, ValidPrice(2.02))
+	assert.False(t, ValidPrice(2.01))
+	assert.True(t, ValidPrice(4.1))
+	assert.False(t, ValidPrice(4.15))
+	assert.True(t, ValidPrice(1000.0))
+	assert.False(t, ValidPrice(1.0))
+	assert.False(t, ValidPrice(1010.0))
+	assert.Len(t, ticks, 350)
+}
+
+func TestRoundPrice(t *testing.T) {
+	assert.Equal(t, 2.02, RoundPrice(2.01, true))
+	assert.Equal(t, 2.0, RoundPrice(2.01, false))
+	assert.Equal(t, 3.05, RoundPrice(3.05, false))
+	assert.Equal(t, 1.01, RoundPrice(1.0, false))
+	assert.Equal(t, 1000.0, RoundPrice(1200.0, true))
+}
+
+func TestNextTick(t *testing.T) {
+	assert.Equal(t, 2.02, NextTick(2.0, 1))
+	assert.Equal(t, 1.99, NextTick(2.0, -1))
+	assert.Equal(t, 3.1, NextTick(2.98, 3))
+	assert.Equal(t, 2.02, NextTick(2.01, 1))
+	assert.Equal(t, 2.0, NextTick(2.01, -1))
+	assert.Equal(t, 1.01, NextTick(1.05, -10))
+	assert.Equal(t, 1000.0, NextTick(990.0, 5))
+	assert.Equal(t, 51, TickDistance(1.5, 2.02))
+	assert.Equal(t, -51, TickDistance(2.02, 1.5))
+}
@@ -0,0 +1,51 @@
+package wagering
+
+import (
+	"math"
+)
+
+// BiasCurve maps a fair probability, as from devigging, to a probability corrected
+// for the favorite-longshot bias, whereby markets overprice longshots and
+// underprice favorites. The results of a curve need not sum to one as
+// AdjustForBias normalizes them.
+// https://en.wikipedia.org/wiki/Favourite-longshot_bias
+type BiasCurve func(p float64) float64
+
+// PowerBiasCurve returns the BiasCurve raising each probability to the power k.
+// Values of k above one shade longshots down and, once normalized, favorites up.
+func PowerBiasCurve(k float64) BiasCurve {
+	return func(p float64) float64 {
+		return math.Pow(p, k)
+	}
+}
+
+// LogitBiasCurve returns the BiasCurve scaling the log odds of each probability by
+// slope, the form fitted to racing results by Snowberg and Wolfers among others.
+// Values of slope above one push probabilities away from one half.
+// https://www.nber.org/papers/w15923
+func LogitBiasCurve(slope float64) BiasCurve {
+	return func(p float64) float64 {
+		if p <= 0.0 || p >= 1.0 {
+			return p
+		}
+		logit := math.Log(p / (1.0 - p))
+		return 1.0 / (1.0 + math.Exp(-slope*logit))
+	}
+}
+
+// AdjustForBias returns the fair probabilities of a market corrected for the
+// favorite-longshot bias by curve and normalized to sum to one.
+func AdjustForBias(probs []Probability, curve BiasCurve) []Probability {
+	var adjusted []float64
+	sum := 0.0
+	for _, p := range probs {
+		a := curve(p.decimal)
+		adjusted = append(adjusted, a)
+		sum += a
+	}
+	var result []Probability
+	for _, a := range adjusted {
+		result = append(result, NewProbabilityFromDecimal(a/sum))
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAdjustForBias(t *testing.T) {
+	fair := EqualMarginProbs(sampleOdds1()...)
+	for _, curve := range []BiasCurve{PowerBiasCurve(1.1), LogitBiasCurve(1.1)} {
+		adjusted := AdjustForBias(fair, curve)
+		assert.Greater(t, adjusted[0].decimal, fair[0].decimal)
+		assert.Less(t, adjusted[2].decimal, fair[2].decimal)
+		assert.InDelta(t, 1.0, adjusted[0].decimal+adjusted[1].decimal+adjusted[2].decimal, 1e-12)
+	}
+
+	identity := AdjustForBias(fair, PowerBiasCurve(1.0))
+	for i := range fair {
+		assert.InDelta(t, fair[i].decimal, identity[i].decimal, 1e-12)
+	}
+
+	custom := AdjustForBias(fair, func(p float64) float64 {
+		if p < 0.3 {
+			return p * 0.9
+		}
+		return p
+	})
+	assert.Less(t, custom[2].decimal, fair[2].decimal)
+}
+
+func TestLogitBiasCurve(t *testing.T) {
+	curve := LogitBiasCurve(2.0)
+	assert.InDelta(t, 0.5, curve(0.5), 1e-12)
+	assert.InDelta(t, 0.2, curve(1.0/3.0), 1e-12)
+	assert.Equal(t, 0.0, curve(0.0))
+}
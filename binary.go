@@ -0,0 +1,119 @@
+package wagering
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// The binary encodings of this package, used by encoding/gob, are compact and
+// fixed width where possible: Odds are the big endian IEEE 754 bits of both
+// formats, so that a round trip is lossless, and a Probability likewise those of
+// its decimal and percent. Types with only exported fields, such as Market, are
+// encoded by gob field by field using the encodings of their Odds.
+
+// errBinaryLength is returned when decoding data of the wrong length.
+var errBinaryLength = errors.New("wagering: invalid binary length")
+
+const (
+	// oddsBinaryLen is the length of the binary encoding of Odds.
+	oddsBinaryLen = 16
+	// probabilityBinaryLen is the length of the binary encoding of a Probability.
+	probabilityBinaryLen = 16
+)
+
+// appendOdds appends the binary encoding of odds to b.
+func appendOdds(b []byte, odds Odds) []byte {
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(odds.decimalOdds))
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(odds.americanOdds))
+}
+
+// decodeOdds returns the Odds binary encoded at the start of data.
+func decodeOdds(data []byte) Odds {
+	return Odds{
+		decimalOdds:  math.Float64frombits(binary.BigEndian.Uint64(data)),
+		americanOdds: math.Float64frombits(binary.BigEndian.Uint64(data[8:])),
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (odds Odds) MarshalBinary() ([]byte, error) {
+	return appendOdds(make([]byte, 0, oddsBinaryLen), odds), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (odds *Odds) UnmarshalBinary(data []byte) error {
+	if len(data) != oddsBinaryLen {
+		return errBinaryLength
+	}
+	*odds = decodeOdds(data)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (prob Probability) MarshalBinary() ([]byte, error) {
+	b := binary.BigEndian.AppendUint64(make([]byte, 0, probabilityBinaryLen), math.Float64bits(prob.decimal))
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(prob.percent)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (prob *Probability) UnmarshalBinary(data []byte) error {
+	if len(data) != probabilityBinaryLen {
+		return errBinaryLength
+	}
+	*prob = Probability{
+		decimal: math.Float64frombits(binary.BigEndian.Uint64(data)),
+		percent: math.Float64frombits(binary.BigEndian.Uint64(data[8:])),
+	}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. A series is encoded as the
+// number of points followed by each point's time, as a length prefixed
+// time.Time.MarshalBinary, and odds.
+func (s OddsSeries) MarshalBinary() ([]byte, error) {
+	b := binary.AppendUvarint(nil, uint64(len(s.points)))
+	for _, p := range s.points {
+		t, err := p.Time.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		b = append(binary.AppendUvarint(b, uint64(len(t))), t...)
+		b = appendOdds(b, p.Odds)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the points of
+// the series.
+func (s *OddsSeries) UnmarshalBinary(data []byte) error {
+	n, k := binary.Uvarint(data)
+	if k <= 0 {
+		return errBinaryLength
+	}
+	data = data[k:]
+	var points []OddsPoint
+	for i := uint64(0); i < n; i++ {
+		tl, k := binary.Uvarint(data)
+		if k <= 0 {
+			return errBinaryLength
+		}
+		// Compare without adding to tl, which may be large enough to overflow.
+		if rem := uint64(len(data) - k); tl > rem || rem-tl < oddsBinaryLen {
+			return errBinaryLength
+		}
+		data = data[k:]
+		var p OddsPoint
+		if err := p.Time.UnmarshalBinary(data[:tl]); err != nil {
+			return err
+		}
+		p.Odds = decodeOdds(data[tl:])
+		data = data[tl+oddsBinaryLen:]
+		points = append(points, p)
+	}
+	if len(data) != 0 {
+		return errBinaryLength
+	}
+	s.points = points
+	return nil
+}
@@ -0,0 +1,68 @@
+package wagering
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// gobRoundTrip encodes v with gob and decodes the result into decoded.
+func gobRoundTrip(t *testing.T, v, decoded any) {
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(v))
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+}
+
+func TestOdds_Binary(t *testing.T) {
+	odds := NewOddsFromAmerican(-110.0)
+	data, err := odds.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Len(t, data, 16)
+	var decoded Odds
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, odds, decoded)
+	assert.Error(t, decoded.UnmarshalBinary(data[1:]))
+
+	decoded = Odds{}
+	gobRoundTrip(t, odds, &decoded)
+	assert.Equal(t, odds, decoded)
+}
+
+func TestProbability_Binary(t *testing.T) {
+	prob := NewProbabilityFromPercent(52.4)
+	var decoded Probability
+	gobRoundTrip(t, prob, &decoded)
+	assert.Equal(t, prob, decoded)
+	assert.Error(t, decoded.UnmarshalBinary(nil))
+}
+
+func TestMarket_Gob(t *testing.T) {
+	m := sampleMarket("pinnacle", -150.0, +130.0)
+	var decoded Market
+	gobRoundTrip(t, m, &decoded)
+	assert.Equal(t, m, decoded)
+}
+
+func TestOddsSeries_Binary(t *testing.T) {
+	series := sampleSeries()
+	var decoded OddsSeries
+	gobRoundTrip(t, series, &decoded)
+	assert.Equal(t, series.Len(), decoded.Len())
+	for i, p := range series.Points() {
+		assert.True(t, p.Time.Equal(decoded.Points()[i].Time))
+		assert.Equal(t, p.Odds, decoded.Points()[i].Odds)
+	}
+
+	data, err := series.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Error(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+	assert.Error(t, decoded.UnmarshalBinary(append(data, 0)))
+	// A time length near the maximum must not overflow the bounds check.
+	malformed := binary.AppendUvarint(binary.AppendUvarint(nil, 1), math.MaxUint64)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(append(malformed, make([]byte, 20)...)), errBinaryLength)
+	assert.NoError(t, decoded.UnmarshalBinary([]byte{0}))
+	assert.Equal(t, 0, decoded.Len())
+}
@@ -0,0 +1,38 @@
+package wagering
+
+import (
+	"math/rand"
+)
+
+// Interval is a confidence interval.
+type Interval struct {
+	Low  float64
+	High float64
+}
+
+// Bootstrap returns the two sided confidence intervals, at the given level such as
+// 0.95, for the ROI and Yield of the settled bets found by resampling them with
+// replacement the given number of times. The resampling is seeded with seed so
+// that the result is deterministic; pass a varying seed, such as the current time,
+// for independent runs.
+// https://en.wikipedia.org/wiki/Bootstrapping_(statistics)
+func (l *Ledger) Bootstrap(resamples int, level float64, seed int64) (roi, yield Interval) {
+	settled := l.settled()
+	rng := rand.New(rand.NewSource(seed))
+	rois := make([]float64, resamples)
+	yields := make([]float64, resamples)
+	for i := 0; i < resamples; i++ {
+		resample := NewLedger()
+		for range settled {
+			resample.Add(settled[rng.Intn(len(settled))])
+		}
+		rois[i] = resample.ROI()
+		yields[i] = resample.Yield()
+	}
+	alpha := 1.0 - level
+	rois = sorted(rois)
+	yields = sorted(yields)
+	roi = Interval{quantile(rois, alpha/2.0), quantile(rois, 1.0-alpha/2.0)}
+	yield = Interval{quantile(yields, alpha/2.0), quantile(yields, 1.0-alpha/2.0)}
+	return roi, yield
+}
@@ -0,0 +1,29 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLedger_Bootstrap(t *testing.T) {
+	l := NewLedger()
+	for i := 0; i < 200; i++ {
+		result := Loss
+		if i%2 == 0 {
+			result = Win
+		}
+		l.Record(NewStraight(NewOddsFromDecimal(2.1), 100.0), Odds{}, result)
+	}
+	roi, yield := l.Bootstrap(500, 0.95, 1)
+	assert.Less(t, roi.Low, l.ROI())
+	assert.Greater(t, roi.High, l.ROI())
+	// The standard error of the ROI is about 1.05 / sqrt(200).
+	assert.InDelta(t, 0.29, roi.High-roi.Low, 0.05)
+	// With equal stakes ROI and yield are the same.
+	assert.InDelta(t, roi.Low, yield.Low, 1e-9)
+
+	again, _ := l.Bootstrap(500, 0.95, 1)
+	assert.Equal(t, roi, again)
+	other, _ := l.Bootstrap(500, 0.95, 2)
+	assert.NotEqual(t, roi, other)
+}
@@ -0,0 +1,16 @@
+package wagering
+
+// CashOutFairValue returns the fair cash out value of an open bet of stake at
+// betOdds, the combined odds for a parlay, given the current fair, de-vigged, odds
+// of each of its unsettled legs. Legs that have already won are omitted.
+func CashOutFairValue(stake float64, betOdds Odds, currentFairOdds ...Odds) float64 {
+	return stake * betOdds.decimalOdds * ParlayOdds(currentFairOdds...).ImpliedProb().decimal
+}
+
+// CashOutEdge returns the expected value of accepting a cash out offer for a bet
+// with the given fair cash out value. The result is given as the percent increase
+// or decrease (negative) of the fair value, so a positive result indicates an
+// offer that is worth accepting.
+func CashOutEdge(offer, fairValue float64) float64 {
+	return offer/fairValue - 1.0
+}
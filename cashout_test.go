@@ -0,0 +1,25 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCashOutFairValue(t *testing.T) {
+	// A straight bet whose side is now a 60% favorite.
+	value := CashOutFairValue(100.0, NewOddsFromAmerican(+150.0), NewOddsFromDecimal(1/0.6))
+	assert.InDelta(t, 150.0, value, 1e-9)
+
+	// A three leg parlay with one leg won and two remaining at even money.
+	betOdds := ParlayOdds(NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0))
+	value = CashOutFairValue(10.0, betOdds, NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0))
+	assert.InDelta(t, 20.0, value, 1e-9)
+
+	// A settled bet is worth its payout.
+	assert.InDelta(t, 80.0, CashOutFairValue(10.0, betOdds), 1e-9)
+}
+
+func TestCashOutEdge(t *testing.T) {
+	assert.InDelta(t, -0.1, CashOutEdge(18.0, 20.0), 1e-9)
+	assert.InDelta(t, 0.05, CashOutEdge(21.0, 20.0), 1e-9)
+}
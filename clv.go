@@ -0,0 +1,71 @@
+package wagering
+
+// CLV returns the closing line value of a bet at betOdds given the closing odds of
+// its selection and, optionally, the closing odds of the other outcomes of the
+// market, which are used to de-vig the close with EqualMarginOdds. Without the
+// other outcomes the closing odds are taken as fair. The edge is the fair closing
+// probability less the implied probability of betOdds, as a decimal, and ev is the
+// expected value of betOdds at the fair closing probability, given as the percent
+// increase or decrease (negative) of the wager.
+func CLV(betOdds, closingOdds Odds, otherOdds ...Odds) (edge, ev float64) {
+	fair := closingOdds
+	if len(otherOdds) > 0 {
+		fair = EqualMarginOdds(append([]Odds{closingOdds}, otherOdds...)...)[0]
+	}
+	fairProb := fair.ImpliedProb()
+	return fairProb.decimal - betOdds.ImpliedProb().decimal, betOdds.ExpectedValueProb(fairProb)
+}
+
+// clvs returns the closing line values of the settled bets with known closing odds.
+func (l *Ledger) clvs() []float64 {
+	var clvs []float64
+	for _, e := range l.settled() {
+		if e.hasClosing() {
+			clvs = append(clvs, e.clv())
+		}
+	}
+	return clvs
+}
+
+// CLVSummary summarizes the distribution of the closing line values, as in
+// Ledger.CLV, of a set of bets.
+type CLVSummary struct {
+	// Count is the number of bets with known closing odds.
+	Count int
+	// BeatCloseRate is the fraction of bets with a positive closing line value.
+	BeatCloseRate float64
+	Mean          float64
+	Median        float64
+	// Quantiles holds the closing line value at each of the requested levels.
+	Quantiles []float64
+}
+
+// CLVSummary returns the summary of the closing line values of the settled bets with
+// known closing odds, including the quantiles at the given levels, 0 to 1. Without
+// any such bets it is the zero CLVSummary.
+func (l *Ledger) CLVSummary(levels ...float64) CLVSummary {
+	clvs := sorted(l.clvs())
+	if len(clvs) == 0 {
+		return CLVSummary{}
+	}
+	summary := CLVSummary{Count: len(clvs)}
+	beat := 0
+	for _, c := range clvs {
+		if c > 0.0 {
+			beat++
+		}
+	}
+	summary.BeatCloseRate = float64(beat) / float64(len(clvs))
+	summary.Mean = mean(clvs)
+	summary.Median = quantile(clvs, 0.5)
+	for _, level := range levels {
+		summary.Quantiles = append(summary.Quantiles, quantile(clvs, level))
+	}
+	return summary
+}
+
+// CLVHistogram returns a histogram, with bins of the given width, of the closing
+// line values of the settled bets with known closing odds.
+func (l *Ledger) CLVHistogram(width float64) []HistogramBin {
+	return histogram(l.clvs(), width)
+}
@@ -0,0 +1,58 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCLV(t *testing.T) {
+	edge, ev := CLV(NewOddsFromDecimal(2.0), NewOddsFromDecimal(1.8))
+	assert.InDelta(t, 0.0556, edge, 0.0001)
+	assert.InDelta(t, 0.1111, ev, 0.0001)
+
+	// -110/-110 closes to a fair +100, no value for a bet at -110.
+	edge, ev = CLV(NewOddsFromAmerican(-110.0), NewOddsFromAmerican(-110.0), NewOddsFromAmerican(-110.0))
+	assert.InDelta(t, -0.0238, edge, 0.0001)
+	assert.InDelta(t, -0.0455, ev, 0.0001)
+
+	// A bet at +100 that closed -130/+110 beat the fair close.
+	edge, ev = CLV(NewOddsFromAmerican(+100.0), NewOddsFromAmerican(-130.0), NewOddsFromAmerican(+110.0))
+	assert.InDelta(t, 0.0427, edge, 0.0001)
+	assert.InDelta(t, 0.0855, ev, 0.0001)
+}
+
+func clvLedger() Ledger {
+	l := NewLedger()
+	for _, closing := range []float64{1.8, 1.9, 2.0, 2.1, 2.5} {
+		l.Record(NewStraight(NewOddsFromDecimal(2.0), 100.0), NewOddsFromDecimal(closing), Win)
+	}
+	l.Record(NewStraight(NewOddsFromDecimal(2.0), 100.0), Odds{}, Loss)
+	return l
+}
+
+func TestLedger_CLVSummary(t *testing.T) {
+	l := clvLedger()
+	summary := l.CLVSummary(0.25, 0.75)
+	assert.Equal(t, 5, summary.Count)
+	assert.InDelta(t, 0.4, summary.BeatCloseRate, 1e-9)
+	assert.InDelta(t, 0.0, summary.Median, 1e-9)
+	assert.InDelta(t, -0.0168, summary.Mean, 0.0001)
+	assert.InDelta(t, -0.0476, summary.Quantiles[0], 0.0001)
+	assert.InDelta(t, 0.0526, summary.Quantiles[1], 0.0001)
+
+	// Without closing odds there is nothing to summarize.
+	l = NewLedger()
+	assert.Equal(t, CLVSummary{}, l.CLVSummary(0.25, 0.75))
+	l.Record(NewStraight(NewOddsFromDecimal(2.0), 100.0), Odds{}, Win)
+	assert.Equal(t, CLVSummary{}, l.CLVSummary(0.25, 0.75))
+}
+
+func TestLedger_CLVHistogram(t *testing.T) {
+	l := clvLedger()
+	bins := l.CLVHistogram(0.1)
+	var counts []int
+	for _, b := range bins {
+		counts = append(counts, b.Count)
+	}
+	assert.Equal(t, []int{1, 1, 2, 1}, counts)
+}
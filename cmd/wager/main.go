@@ -0,0 +1,326 @@
+// Command wager wraps package wagering for use in shell pipelines.
+//
+// Usage:
+//
+//	wager convert [-json] odds...
+//	wager devig [-json] [-method multiplicative] [-max-iterations 0] odds...
+//	wager kelly [-json] -prob p [-mult 1] [-bankroll 0] odds
+//	wager arb [-json] [-stake 1000] [-increment 0] odds...
+//	wager parlay [-json] [-stake 0] odds...
+//
+// Odds are american when signed, such as -110 or +150, fractional when containing a
+// slash, such as 5/2, and decimal otherwise, such as 2.5. Without odds arguments
+// whitespace separated odds are read from standard input.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dburger/wagering"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "wager:", err)
+		os.Exit(1)
+	}
+}
+
+// errUsage is returned for a missing or unknown subcommand.
+var errUsage = errors.New("usage: wager convert|devig|kelly|arb|parlay [flags] [odds...]")
+
+// subcommand registers its flags on fs and returns the function computing its
+// result from the odds given, as both a value to encode as JSON and text.
+type subcommand func(fs *flag.FlagSet) func(odds []wagering.Odds) (any, string, error)
+
+var subcommands = map[string]subcommand{
+	"convert": convert,
+	"devig":   devig,
+	"kelly":   kelly,
+	"arb":     arb,
+	"parlay":  parlay,
+}
+
+// run runs the subcommand of args, reading odds from stdin if none are given, and
+// writes its result to stdout as text or, with -json, JSON.
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	cmd, ok := subcommands[args[0]]
+	if !ok {
+		return errUsage
+	}
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	asJSON := fs.Bool("json", false, "write JSON rather than text")
+	exec := cmd(fs)
+	tokens, err := parseArgs(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			tokens = append(tokens, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+	}
+	if len(tokens) == 0 {
+		return errors.New("no odds given")
+	}
+	var odds []wagering.Odds
+	for _, t := range tokens {
+		o, err := parseOdds(t)
+		if err != nil {
+			return err
+		}
+		odds = append(odds, o)
+	}
+	result, text, err := exec(odds)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+	_, err = io.WriteString(stdout, text)
+	return err
+}
+
+// parseArgs parses the flags of args, which may be interspersed with odds, into fs
+// and returns the odds. Unlike fs.Parse, negative american odds are not taken to be
+// flags.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var odds []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if _, err := strconv.ParseFloat(arg, 64); err == nil || !strings.HasPrefix(arg, "-") {
+			odds = append(odds, arg)
+			continue
+		}
+		flagArgs := []string{arg}
+		name := strings.TrimLeft(arg, "-")
+		if f := fs.Lookup(name); f != nil && i+1 < len(args) {
+			if bf, ok := f.Value.(interface{ IsBoolFlag() bool }); !ok || !bf.IsBoolFlag() {
+				i++
+				flagArgs = append(flagArgs, args[i])
+			}
+		}
+		if err := fs.Parse(flagArgs); err != nil {
+			return nil, err
+		}
+	}
+	return odds, nil
+}
+
+// parseOdds parses american odds if signed, fractional odds if containing a slash
+// and decimal odds otherwise.
+func parseOdds(s string) (wagering.Odds, error) {
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || (v > -100.0 && v < 100.0) {
+			return wagering.Odds{}, fmt.Errorf("invalid american odds %q", s)
+		}
+		return wagering.NewOddsFromAmerican(v), nil
+	}
+	ro, err := wagering.ParseRationalOdds(s)
+	if err != nil {
+		return wagering.Odds{}, err
+	}
+	return ro.Odds(), nil
+}
+
+// table formats rows as tab aligned text.
+func table(rows ...[]string) string {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+	return sb.String()
+}
+
+// american formats the american odds of odds, signed.
+func american(odds wagering.Odds) string {
+	return fmt.Sprintf("%+.0f", odds.American())
+}
+
+// decimal formats the decimal odds of odds.
+func decimal(odds wagering.Odds) string {
+	return fmt.Sprintf("%.3f", odds.Decimal())
+}
+
+// percent formats a decimal as a percent.
+func percent(v float64) string {
+	return fmt.Sprintf("%.2f%%", v*100.0)
+}
+
+// money formats an amount.
+func money(v float64) string {
+	return fmt.Sprintf("%.2f", v)
+}
+
+// oddsResult is the JSON representation of odds and their implied probability.
+type oddsResult struct {
+	American float64 `json:"american"`
+	Decimal  float64 `json:"decimal"`
+	Implied  float64 `json:"implied"`
+}
+
+// newOddsResult returns the oddsResult of odds.
+func newOddsResult(odds wagering.Odds) oddsResult {
+	return oddsResult{American: odds.American(), Decimal: odds.Decimal(), Implied: odds.ImpliedProb().Decimal()}
+}
+
+func convert(_ *flag.FlagSet) func([]wagering.Odds) (any, string, error) {
+	return func(odds []wagering.Odds) (any, string, error) {
+		var results []oddsResult
+		rows := [][]string{{"AMERICAN", "DECIMAL", "IMPLIED"}}
+		for _, o := range odds {
+			results = append(results, newOddsResult(o))
+			rows = append(rows, []string{american(o), decimal(o), percent(o.ImpliedProb().Decimal())})
+		}
+		return results, table(rows...), nil
+	}
+}
+
+// devigResult is the JSON representation of a devigged market.
+type devigResult struct {
+	Method string       `json:"method"`
+	Margin float64      `json:"margin"`
+	Fair   []oddsResult `json:"fair"`
+}
+
+// solvers holds the variants of the iterative devig methods reporting whether their
+// solver converged.
+var solvers = map[wagering.DevigMethod]func(wagering.DevigOptions, ...wagering.Odds) wagering.DevigResult{
+	wagering.Shin:        wagering.ShinOddsWithOptions,
+	wagering.OddsRatio:   wagering.OddsRatioOddsWithOptions,
+	wagering.Logarithmic: wagering.LogarithmicOddsWithOptions,
+}
+
+func devig(fs *flag.FlagSet) func([]wagering.Odds) (any, string, error) {
+	method := fs.String("method", wagering.Multiplicative.String(), "devig method")
+	maxIterations := fs.Int("max-iterations", 0, "most solver iterations of iterative methods, 0 for the default")
+	return func(odds []wagering.Odds) (any, string, error) {
+		m, err := wagering.ParseDevigMethod(*method)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(odds) < 2 {
+			return nil, "", errors.New("devig requires the odds of at least two outcomes")
+		}
+		var fair []wagering.Odds
+		if solve, ok := solvers[m]; ok {
+			dr := solve(wagering.DevigOptions{MaxIterations: *maxIterations}, odds...)
+			if err := dr.Err(); err != nil {
+				return nil, "", fmt.Errorf("%s: %w", m, err)
+			}
+			fair = dr.Odds
+		} else {
+			fair = wagering.Devig(m, odds...)
+		}
+		result := devigResult{Method: m.String(), Margin: wagering.Overround(odds...) - 1.0}
+		rows := [][]string{{"ODDS", "FAIR", "DECIMAL", "PROB"}}
+		for i, f := range fair {
+			result.Fair = append(result.Fair, newOddsResult(f))
+			rows = append(rows, []string{american(odds[i]), american(f), decimal(f), percent(f.ImpliedProb().Decimal())})
+		}
+		return result, table(rows...) + "margin " + percent(result.Margin) + "\n", nil
+	}
+}
+
+// kellyResult is the JSON representation of a Kelly stake.
+type kellyResult struct {
+	EV       float64 `json:"ev"`
+	Fraction float64 `json:"fraction"`
+	Stake    float64 `json:"stake"`
+}
+
+func kelly(fs *flag.FlagSet) func([]wagering.Odds) (any, string, error) {
+	prob := fs.Float64("prob", 0.0, "probability of winning, 0 to 1")
+	mult := fs.Float64("mult", 1.0, "Kelly multiplier")
+	bankroll := fs.Float64("bankroll", 0.0, "bankroll to stake from")
+	return func(odds []wagering.Odds) (any, string, error) {
+		if len(odds) != 1 {
+			return nil, "", errors.New("kelly requires exactly one odds")
+		}
+		if *prob <= 0.0 || *prob >= 1.0 {
+			return nil, "", errors.New("kelly requires -prob between 0 and 1")
+		}
+		p := wagering.NewProbabilityFromDecimal(*prob)
+		fraction := odds[0].KellyFraction(p, *mult)
+		result := kellyResult{EV: odds[0].ExpectedValueProb(p), Fraction: fraction, Stake: fraction * *bankroll}
+		text := table(
+			[]string{"ev", percent(result.EV)},
+			[]string{"fraction", percent(result.Fraction)},
+			[]string{"stake", money(result.Stake)},
+		)
+		return result, text, nil
+	}
+}
+
+// arbResult is the JSON representation of an arbitrage.
+type arbResult struct {
+	Arbitrage bool      `json:"arbitrage"`
+	Stakes    []float64 `json:"stakes"`
+	Profit    float64   `json:"profit"`
+}
+
+func arb(fs *flag.FlagSet) func([]wagering.Odds) (any, string, error) {
+	stake := fs.Float64("stake", 1000.0, "total stake")
+	increment := fs.Float64("increment", 0.0, "increment to round stakes to, 0 for none")
+	return func(odds []wagering.Odds) (any, string, error) {
+		if len(odds) < 2 {
+			return nil, "", errors.New("arb requires the odds of at least two outcomes")
+		}
+		a, ok := wagering.ArbitrageN(*stake, *increment, odds...)
+		result := arbResult{Arbitrage: ok, Stakes: a.Stakes, Profit: a.Profit}
+		rows := [][]string{{"ODDS", "STAKE"}}
+		for i, s := range a.Stakes {
+			rows = append(rows, []string{american(odds[i]), money(s)})
+		}
+		text := table(rows...) + "profit " + money(a.Profit) + "\n"
+		if !ok {
+			text += "no arbitrage\n"
+		}
+		return result, text, nil
+	}
+}
+
+// parlayResult is the JSON representation of a parlay.
+type parlayResult struct {
+	oddsResult
+	Payout float64 `json:"payout"`
+}
+
+func parlay(fs *flag.FlagSet) func([]wagering.Odds) (any, string, error) {
+	stake := fs.Float64("stake", 0.0, "amount wagered")
+	return func(odds []wagering.Odds) (any, string, error) {
+		combined := wagering.ParlayOdds(odds...)
+		result := parlayResult{oddsResult: newOddsResult(combined), Payout: *stake * combined.Decimal()}
+		text := table(
+			[]string{"american", american(combined)},
+			[]string{"decimal", decimal(combined)},
+			[]string{"implied", percent(combined.ImpliedProb().Decimal())},
+			[]string{"payout", money(result.Payout)},
+		)
+		return result, text, nil
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dburger/wagering"
+	"github.com/stretchr/testify/assert"
+)
+
+// runString runs args with stdin and returns what was written to stdout.
+func runString(t *testing.T, stdin string, args ...string) (string, error) {
+	var out bytes.Buffer
+	err := run(args, strings.NewReader(stdin), &out)
+	return out.String(), err
+}
+
+func TestParseOdds(t *testing.T) {
+	odds, err := parseOdds("-110")
+	assert.NoError(t, err)
+	assert.Equal(t, -110.0, odds.American())
+	odds, err = parseOdds("5/2")
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, odds.Decimal())
+	odds, err = parseOdds("1.91")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.91, odds.Decimal())
+	_, err = parseOdds("+50")
+	assert.Error(t, err)
+	_, err = parseOdds("abc")
+	assert.Error(t, err)
+}
+
+func TestRun_Convert(t *testing.T) {
+	out, err := runString(t, "", "convert", "-110", "+150")
+	assert.NoError(t, err)
+	assert.Equal(t, "AMERICAN  DECIMAL  IMPLIED\n-110      1.909    52.38%\n+150      2.500    40.00%\n", out)
+
+	out, err = runString(t, "2.0\n", "convert", "-json")
+	assert.NoError(t, err)
+	var results []oddsResult
+	assert.NoError(t, json.Unmarshal([]byte(out), &results))
+	assert.Equal(t, []oddsResult{{American: 100.0, Decimal: 2.0, Implied: 0.5}}, results)
+}
+
+func TestRun_Devig(t *testing.T) {
+	out, err := runString(t, "-110 -110", "devig", "-json", "-method", "additive")
+	assert.NoError(t, err)
+	var result devigResult
+	assert.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.Equal(t, "additive", result.Method)
+	assert.InDelta(t, 0.0476, result.Margin, 0.0001)
+	assert.InDelta(t, 2.0, result.Fair[1].Decimal, 1e-9)
+
+	_, err = runString(t, "", "devig", "-method", "bogus", "-110", "-110")
+	assert.Error(t, err)
+	_, err = runString(t, "", "devig", "-110")
+	assert.Error(t, err)
+
+	out, err = runString(t, "", "devig", "-method", "shin", "2.6", "3.1", "2.9")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "margin")
+	_, err = runString(t, "", "devig", "-method", "shin", "-max-iterations", "1", "2.6", "3.1", "2.9")
+	assert.ErrorIs(t, err, wagering.ErrNotConverged)
+}
+
+func TestRun_Kelly(t *testing.T) {
+	out, err := runString(t, "", "kelly", "-prob", "0.55", "-110", "-bankroll", "1000", "-mult=0.5")
+	assert.NoError(t, err)
+	assert.Equal(t, "ev        5.00%\nfraction  2.75%\nstake     27.50\n", out)
+
+	_, err = runString(t, "", "kelly", "-110")
+	assert.Error(t, err)
+}
+
+func TestRun_Arb(t *testing.T) {
+	out, err := runString(t, "", "arb", "-json", "-increment", "1", "2.6", "3.5", "3.4")
+	assert.NoError(t, err)
+	var result arbResult
+	assert.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.True(t, result.Arbitrage)
+	assert.Equal(t, []float64{399.0, 296.0, 305.0}, result.Stakes)
+
+	out, err = runString(t, "", "arb", "-110", "-110")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "no arbitrage")
+}
+
+func TestRun_Parlay(t *testing.T) {
+	out, err := runString(t, "", "parlay", "-stake", "10", "2.0", "2.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "american  +300\ndecimal   4.000\nimplied   25.00%\npayout    40.00\n", out)
+}
+
+func TestRun_Errors(t *testing.T) {
+	_, err := runString(t, "")
+	assert.Equal(t, errUsage, err)
+	_, err = runString(t, "", "bogus")
+	assert.Equal(t, errUsage, err)
+	_, err = runString(t, "", "convert")
+	assert.EqualError(t, err, "no odds given")
+	_, err = runString(t, "", "convert", "-nope")
+	assert.Error(t, err)
+}
@@ -0,0 +1,160 @@
+package wagering
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// The CSV format for a Ledger has a header row followed by one row per entry with
+// the columns:
+//
+//	date     the date the bet was placed as YYYY-MM-DD, or empty if unknown
+//	market   a free form description of the market
+//	format   the odds format of price and closing, "american" or "decimal"
+//	price    the odds of the bet
+//	stake    the amount wagered
+//	result   the result, "win", "loss", "push", "half win", or "half loss", or
+//	         empty if the bet is pending
+//	closing  the closing odds of the selection, or empty if unknown
+//
+// Every bet is written at its combined odds and read back as a Straight.
+var csvHeader = []string{"date", "market", "format", "price", "stake", "result", "closing"}
+
+const (
+	americanFormat = "american"
+	decimalFormat  = "decimal"
+)
+
+// formatOdds returns odds as a string in the given format.
+func formatOdds(odds Odds, format string) string {
+	if format == americanFormat {
+		s := strconv.FormatFloat(odds.americanOdds, 'f', -1, 64)
+		if odds.americanOdds > 0 {
+			s = "+" + s
+		}
+		return s
+	}
+	return strconv.FormatFloat(odds.decimalOdds, 'f', -1, 64)
+}
+
+// parseOdds returns the Odds represented by s in the given format.
+func parseOdds(s, format string) (Odds, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Odds{}, err
+	}
+	switch format {
+	case americanFormat:
+		return NewOddsFromAmerican(v), nil
+	case decimalFormat:
+		return NewOddsFromDecimal(v), nil
+	}
+	return Odds{}, fmt.Errorf("unknown odds format %q", format)
+}
+
+// WriteCSV writes the entries of the ledger to w in the CSV format described above
+// with odds in the given format, "american" or "decimal".
+func (l *Ledger) WriteCSV(w io.Writer, format string) error {
+	if format != americanFormat && format != decimalFormat {
+		return fmt.Errorf("unknown odds format %q", format)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range l.entries {
+		date := ""
+		if !e.Time.IsZero() {
+			date = e.Time.Format(time.DateOnly)
+		}
+		result := ""
+		if !e.Pending {
+			result = e.Result.String()
+		}
+		closing := ""
+		if e.hasClosing() {
+			closing = formatOdds(e.Closing, format)
+		}
+		record := []string{
+			date,
+			e.Market,
+			format,
+			formatOdds(e.Bet.Odds(), format),
+			strconv.FormatFloat(e.Bet.Stake(), 'f', -1, 64),
+			result,
+			closing,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// parseEntry returns the LedgerEntry represented by a CSV record.
+func parseEntry(record []string) (LedgerEntry, error) {
+	var e LedgerEntry
+	if len(record) != len(csvHeader) {
+		return e, fmt.Errorf("expected %d columns but found %d", len(csvHeader), len(record))
+	}
+	date, market, format, price, stake, result, closing := record[0], record[1], record[2], record[3], record[4], record[5], record[6]
+	var err error
+	if date != "" {
+		if e.Time, err = time.Parse(time.DateOnly, date); err != nil {
+			return e, err
+		}
+	}
+	e.Market = market
+	odds, err := parseOdds(price, format)
+	if err != nil {
+		return e, err
+	}
+	amount, err := strconv.ParseFloat(stake, 64)
+	if err != nil {
+		return e, err
+	}
+	if result == "" {
+		e.Pending = true
+	} else if e.Result, err = ParseResult(result); err != nil {
+		return e, err
+	}
+	if closing != "" {
+		if e.Closing, err = parseOdds(closing, format); err != nil {
+			return e, err
+		}
+	}
+	e.Bet = NewStraight(odds, amount)
+	if !e.Pending {
+		if _, e.Payout, err = e.Bet.Settle(e.Result); err != nil {
+			return e, err
+		}
+	}
+	return e, nil
+}
+
+// ReadCSV reads entries in the CSV format described above from r and adds them to
+// the ledger. No entries are added if an error is encountered.
+func (l *Ledger) ReadCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	records, err := cr.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	var entries []LedgerEntry
+	for i, record := range records[1:] {
+		e, err := parseEntry(record)
+		if err != nil {
+			return fmt.Errorf("row %d: %w", i+2, err)
+		}
+		entries = append(entries, e)
+	}
+	l.entries = append(l.entries, entries...)
+	return nil
+}
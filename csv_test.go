@@ -0,0 +1,70 @@
+package wagering
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleLedgerCSV = `date,market,format,price,stake,result,closing
+2023-09-10,Chiefs -3.5,american,-110,110,win,-120
+2023-09-11,Jets ML,american,+150,50,loss,
+,Bills/Dolphins o47.5,american,-105,100,push,-110
+`
+
+func TestLedger_ReadCSV(t *testing.T) {
+	l := NewLedger()
+	assert.NoError(t, l.ReadCSV(strings.NewReader(sampleLedgerCSV)))
+	assert.Equal(t, 3, l.Len())
+
+	e := l.Entries()[0]
+	assert.Equal(t, time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC), e.Time)
+	assert.Equal(t, "Chiefs -3.5", e.Market)
+	assert.Equal(t, -110.0, e.Bet.Odds().americanOdds)
+	assert.Equal(t, 110.0, e.Bet.Stake())
+	assert.Equal(t, Win, e.Result)
+	assert.InDelta(t, 210.0, e.Payout, 1e-9)
+	assert.Equal(t, -120.0, e.Closing.americanOdds)
+
+	assert.False(t, l.Entries()[1].hasClosing())
+	assert.True(t, l.Entries()[2].Time.IsZero())
+	assert.InDelta(t, 50.0, l.Profit(), 1e-9)
+}
+
+func TestLedger_ReadCSVError(t *testing.T) {
+	l := NewLedger()
+	err := l.ReadCSV(strings.NewReader(sampleLedgerCSV + "2023-09-12,Bad,american,-110,100,maybe,\n"))
+	assert.EqualError(t, err, `row 5: unknown result "maybe"`)
+	assert.Equal(t, 0, l.Len())
+
+	err = l.ReadCSV(strings.NewReader("date,market,format,price,stake,result,closing\n,M,fractional,5/2,10,win,\n"))
+	assert.Error(t, err)
+}
+
+func TestLedger_WriteCSV(t *testing.T) {
+	l := NewLedger()
+	assert.NoError(t, l.ReadCSV(strings.NewReader(sampleLedgerCSV)))
+	var buf bytes.Buffer
+	assert.NoError(t, l.WriteCSV(&buf, "american"))
+	assert.Equal(t, sampleLedgerCSV, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, l.WriteCSV(&buf, "decimal"))
+	assert.Contains(t, buf.String(), "2023-09-11,Jets ML,decimal,2.5,50,loss,\n")
+
+	assert.Error(t, l.WriteCSV(&buf, "fractional"))
+}
+
+func TestLedger_CSVPending(t *testing.T) {
+	const pendingCSV = "date,market,format,price,stake,result,closing\n2023-09-17,Chiefs -3.5,american,-110,110,,\n"
+	l := NewLedger()
+	assert.NoError(t, l.ReadCSV(strings.NewReader(pendingCSV)))
+	assert.True(t, l.Entries()[0].Pending)
+	assert.Equal(t, 110.0, l.Exposure().Staked)
+
+	var buf bytes.Buffer
+	assert.NoError(t, l.WriteCSV(&buf, "american"))
+	assert.Equal(t, pendingCSV, buf.String())
+}
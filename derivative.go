@@ -0,0 +1,61 @@
+package wagering
+
+import (
+	"math"
+)
+
+// TeamTotals returns the expected points of a side with the given point spread,
+// such as -7 for a seven point favorite, and of its opponent in a game with the
+// given total, the fair team total lines.
+func TeamTotals(spread, total float64) (team, opponent float64) {
+	return (total - spread) / 2.0, (total + spread) / 2.0
+}
+
+// TeamTotalOverProb returns the probability that a side with the given point spread
+// scores more than line points in a game with the given total, where dist is the
+// distribution of the side's score about its expected points.
+func TeamTotalOverProb(spread, total, line float64, dist MarginDistribution) Probability {
+	team, _ := TeamTotals(spread, total)
+	return NewProbabilityFromDecimal(1.0 - dist.CDF(line-team))
+}
+
+// RaceToProb returns the probability that a side with the given point spread
+// reaches n points before its opponent in a game with the given total. Points are
+// modeled as arriving one at a time, each to the side in proportion to its
+// expected points, and the game is assumed to last long enough for one side to
+// reach n.
+func RaceToProb(spread, total float64, n int) Probability {
+	team, opponent := TeamTotals(spread, total)
+	p := team / (team + opponent)
+	// The side wins the race when its nth point arrives after k < n opponent points,
+	// a negative binomial sum.
+	prob := 0.0
+	coefficient := 1.0
+	for k := 0; k < n; k++ {
+		if k > 0 {
+			coefficient *= float64(n-1+k) / float64(k)
+		}
+		prob += coefficient * math.Pow(p, float64(n)) * math.Pow(1.0-p, float64(k))
+	}
+	return NewProbabilityFromDecimal(prob)
+}
+
+// MarginBandProbs returns the probability that the final margin of victory of a side
+// with the given point spread, negative for a loss, falls in each band, where dist
+// is the distribution of the margin about the negative of the spread. The bands
+// are given by their whole number lower bounds in increasing order, each band
+// running up to the next bound and the last unbounded, so 1, 7, 13 gives the bands
+// 1 to 6, 7 to 12 and 13 or more. Margins are treated as whole numbers by
+// continuity correction.
+func MarginBandProbs(spread float64, dist MarginDistribution, bounds ...int) []Probability {
+	var probs []Probability
+	for i, b := range bounds {
+		low := dist.CDF(float64(b) - 0.5 + spread)
+		high := 1.0
+		if i+1 < len(bounds) {
+			high = dist.CDF(float64(bounds[i+1]) - 0.5 + spread)
+		}
+		probs = append(probs, NewProbabilityFromDecimal(high-low))
+	}
+	return probs
+}
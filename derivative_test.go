@@ -0,0 +1,36 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTeamTotals(t *testing.T) {
+	team, opponent := TeamTotals(-7.0, 45.0)
+	assert.Equal(t, 26.0, team)
+	assert.Equal(t, 19.0, opponent)
+
+	dist := NormalMargin{Sigma: 10.0}
+	assert.InDelta(t, 0.5, TeamTotalOverProb(-7.0, 45.0, 26.0, dist).decimal, 1e-12)
+	assert.InDelta(t, 0.3085, TeamTotalOverProb(-7.0, 45.0, 31.0, dist).decimal, 0.0001)
+}
+
+func TestRaceToProb(t *testing.T) {
+	assert.InDelta(t, 0.5, RaceToProb(0.0, 45.0, 10).decimal, 1e-12)
+	// With p = 0.6 racing to two: p^2 + 2p^2(1-p).
+	assert.InDelta(t, 0.648, RaceToProb(-9.0, 45.0, 2).decimal, 1e-12)
+	assert.Greater(t, RaceToProb(-9.0, 45.0, 10).decimal, RaceToProb(-9.0, 45.0, 2).decimal)
+}
+
+func TestMarginBandProbs(t *testing.T) {
+	dist := NormalMargin{Sigma: NFLMarginSigma}
+	probs := MarginBandProbs(-7.0, dist, -1000, 1, 7, 13)
+	sum := 0.0
+	for _, p := range probs {
+		sum += p.decimal
+	}
+	assert.InDelta(t, 1.0, sum, 1e-12)
+	// Losing or tying is a margin below 0.5.
+	assert.InDelta(t, 1.0-SpreadWinProb(-6.5, dist).decimal, probs[0].decimal, 1e-12)
+	assert.InDelta(t, 0.3457, probs[3].decimal, 0.0001)
+}
@@ -0,0 +1,561 @@
+package wagering
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ErrNotConverged is returned, wrapped, by DevigResult.Err when the solver of an
+// iterative devig method did not converge.
+var ErrNotConverged = errors.New("devig did not converge")
+
+const (
+	defaultTolerance     = 1e-12
+	defaultMaxIterations = 1000
+	// maxResidual is the largest Residual of a converged solution, unless the
+	// Tolerance is larger.
+	maxResidual = 1e-9
+)
+
+// DevigOptions configures the solvers of the iterative devig methods, which find
+// their fitted parameter with safeguarded Newton's method. Zero values select the
+// defaults.
+type DevigOptions struct {
+	// Tolerance is the Newton step in the fitted parameter below which the solver
+	// has converged. Defaults to 1e-12.
+	Tolerance float64
+	// MaxIterations is the most iterations the solver performs. Defaults to 1000,
+	// though the solvers converge in a handful.
+	MaxIterations int
+	// InitialGuess is the starting value of the fitted parameter. Defaults to 0 for
+	// Shin's z and 1 otherwise.
+	InitialGuess float64
+}
+
+func (do DevigOptions) tolerance() float64 {
+	if do.Tolerance <= 0.0 {
+		return defaultTolerance
+	}
+	return do.Tolerance
+}
+
+func (do DevigOptions) maxIterations() int {
+	if do.MaxIterations <= 0 {
+		return defaultMaxIterations
+	}
+	return do.MaxIterations
+}
+
+func (do DevigOptions) initialGuess(defaultGuess float64) float64 {
+	if do.InitialGuess == 0.0 {
+		return defaultGuess
+	}
+	return do.InitialGuess
+}
+
+// DevigResult holds the fair odds found by an iterative devig method along with
+// diagnostics of its solver.
+type DevigResult struct {
+	Odds  []Odds
+	Probs []Probability
+	// Param is the fitted parameter of the method, such as Shin's z or the c of the
+	// odds ratio and logarithmic methods.
+	Param float64
+	// Iterations is the number of iterations used.
+	Iterations int
+	// Residual is the distance of the sum of the fair probabilities from one.
+	Residual float64
+	// Converged is whether the solver converged within the tolerance before
+	// exhausting the iterations, leaving a Residual no larger than the greater of
+	// the tolerance and 1e-9. When false the odds should not be trusted and a
+	// method without a solver, such as EqualMarginOdds, is a sensible fallback.
+	Converged bool
+}
+
+// Err returns an error wrapping ErrNotConverged if the solver did not converge,
+// nil otherwise.
+func (dr DevigResult) Err() error {
+	if dr.Converged {
+		return nil
+	}
+	return fmt.Errorf("%w after %d iterations, param %v, residual %v", ErrNotConverged, dr.Iterations, dr.Param, dr.Residual)
+}
+
+// devigSolution is the fitted parameter of an iterative devig method and the
+// diagnostics of its solver.
+type devigSolution struct {
+	param      float64
+	iterations int
+	converged  bool
+}
+
+// result returns the DevigResult of the solution found with options and the fair
+// probabilities.
+func (ds devigSolution) result(options DevigOptions, fair []float64) DevigResult {
+	dr := DevigResult{Param: ds.param, Iterations: ds.iterations, Converged: ds.converged}
+	dr.Odds = make([]Odds, 0, len(fair))
+	dr.Probs = make([]Probability, 0, len(fair))
+	sum := 0.0
+	for _, p := range fair {
+		dr.Odds = append(dr.Odds, NewOddsFromDecimal(1.0/p))
+		dr.Probs = append(dr.Probs, NewProbabilityFromDecimal(p))
+		sum += p
+	}
+	dr.Residual = math.Abs(1.0 - sum)
+	// A diverged solver can leave NaN probabilities despite a small step, and one
+	// pinned against the edge of its bracket a residual.
+	dr.Converged = dr.Converged && dr.Residual <= max(options.tolerance(), maxResidual)
+	return dr
+}
+
+// The devig methods are implemented on buffers of decimal probabilities, writing
+// the fair probabilities of the implied probabilities p into fair, of the same
+// length, which may be p itself. They do not allocate, so that large batches of
+// markets can be devigged without allocation pressure.
+
+// total returns the sum of values.
+func total(values []float64) float64 {
+	s := 0.0
+	for _, v := range values {
+		s += v
+	}
+	return s
+}
+
+// multiplicativeInto implements EqualMarginOdds.
+func multiplicativeInto(fair, p []float64) {
+	overround := total(p)
+	for i := range p {
+		fair[i] = p[i] / overround
+	}
+}
+
+// additiveInto implements AdditiveOdds.
+func additiveInto(fair, p []float64) {
+	share := (total(p) - 1.0) / float64(len(p))
+	for i := range p {
+		fair[i] = p[i] - share
+	}
+}
+
+// mptoInto implements MPTOdds.
+func mptoInto(fair, p []float64) {
+	n := float64(len(p))
+	m := total(p) - 1.0
+	for i := range p {
+		o := 1.0 / p[i]
+		fair[i] = (n - m*o) / (n * o)
+	}
+}
+
+// newton returns the root of f, decreasing over the bracket (lo, hi) within which
+// it changes sign, found by Newton's method from x. fdf returns f and its
+// derivative at x. The bracket narrows as f is evaluated and a step leaving it is
+// replaced by bisection, or by stepping outward while the bracket is unbounded, so
+// that the solver neither diverges nor oscillates on high margin markets. It has
+// converged when a step is smaller than the tolerance of options.
+func newton(options DevigOptions, x, lo, hi float64, fdf func(x float64) (f, df float64)) devigSolution {
+	convergenceThreshold := options.tolerance()
+	maxIterations := options.maxIterations()
+	for iterations := 1; iterations <= maxIterations; iterations++ {
+		f, df := fdf(x)
+		switch {
+		case f > 0.0:
+			lo = x
+		case f < 0.0:
+			hi = x
+		default:
+			return devigSolution{param: x, iterations: iterations, converged: true}
+		}
+		next := x - f/df
+		if next != x && !(next > lo && next < hi) {
+			if math.IsInf(hi, 1) {
+				next = 2.0*math.Max(x, lo) + 1.0
+			} else if math.IsInf(lo, -1) {
+				next = 2.0*math.Min(x, hi) - 1.0
+			} else {
+				next = (lo + hi) / 2.0
+			}
+		}
+		step := next - x
+		x = next
+		if math.Abs(step) < convergenceThreshold {
+			return devigSolution{param: x, iterations: iterations, converged: true}
+		}
+	}
+	return devigSolution{param: x, iterations: maxIterations, converged: false}
+}
+
+// shinFair returns the fair probability of an outcome with implied probability p
+// given Shin's z and the overround, and its derivative with respect to z.
+func shinFair(p, z, overround float64) (fair, dfair float64) {
+	q := p * p / overround
+	s := math.Sqrt(z*z + 4*(1-z)*q)
+	ds := (z - 2*q) / s
+	fair = (s - z) / (2 * (1 - z))
+	dfair = ((ds-1)*(1-z) + (s - z)) / (2 * (1 - z) * (1 - z))
+	return fair, dfair
+}
+
+// shinInto implements ShinOdds, solving for the z below one at which the fair
+// probabilities sum to one. An underround market, such as the best lines across
+// books, has a negative z.
+func shinInto(options DevigOptions, fair, p []float64) devigSolution {
+	overround := total(p)
+	var solution devigSolution
+	if len(p) == 2 {
+		solution = devigSolution{param: shinTwoWayZ(p[0], p[1], overround), converged: true}
+	} else {
+		solution = newton(options, options.initialGuess(0.0), math.Inf(-1), 1.0, func(z float64) (float64, float64) {
+			f, df := -1.0, 0.0
+			for _, pi := range p {
+				fi, dfi := shinFair(pi, z, overround)
+				f += fi
+				df += dfi
+			}
+			return f, df
+		})
+	}
+
+	// Now use z to make the true odds.
+	for i, pi := range p {
+		fair[i], _ = shinFair(pi, solution.param, overround)
+	}
+	return solution
+}
+
+// oddsRatioInto implements OddsRatioOdds, solving for the positive odds ratio c at
+// which the fair probabilities sum to one.
+func oddsRatioInto(options DevigOptions, fair, p []float64) devigSolution {
+	solution := newton(options, options.initialGuess(1.0), 0.0, math.Inf(1), func(c float64) (float64, float64) {
+		f, df := -1.0, 0.0
+		for _, pi := range p {
+			d := c*(1/pi-1) + 1
+			f += 1 / d
+			df -= (1/pi - 1) / (d * d)
+		}
+		return f, df
+	})
+
+	// Now use c to make the true odds.
+	for i, pi := range p {
+		fair[i] = 1 / (solution.param/pi + 1 - solution.param)
+	}
+	return solution
+}
+
+// logarithmicInto implements LogarithmicOdds, solving for the positive power c at
+// which the fair probabilities sum to one.
+func logarithmicInto(options DevigOptions, fair, p []float64) devigSolution {
+	solution := newton(options, options.initialGuess(1.0), 0.0, math.Inf(1), func(c float64) (float64, float64) {
+		f, df := -1.0, 0.0
+		for _, pi := range p {
+			pc := math.Pow(pi, c)
+			f += pc
+			df += pc * math.Log(pi)
+		}
+		return f, df
+	})
+
+	// Now use c to make the true odds.
+	for i, pi := range p {
+		fair[i] = math.Pow(pi, solution.param)
+	}
+	return solution
+}
+
+// withOptions returns the DevigResult of devigging odds with solve.
+func withOptions(solve func(DevigOptions, []float64, []float64) devigSolution, options DevigOptions, odds []Odds) DevigResult {
+	fair := ImpliedProbsInto(make([]float64, len(odds)), odds...)
+	return solve(options, fair, fair).result(options, fair)
+}
+
+// ShinOddsWithOptions implements ShinOdds with the given solver options, returning
+// the fitted z along with the fair odds.
+func ShinOddsWithOptions(options DevigOptions, odds ...Odds) DevigResult {
+	return withOptions(shinInto, options, odds)
+}
+
+// shinTwoWayZ returns the closed form of Shin's z for a two outcome market with
+// the given implied probabilities and their sum, the overround.
+// https://cran.r-project.org/web/packages/implied/vignettes/introduction.html
+func shinTwoWayZ(p1, p2, overround float64) float64 {
+	diff := p1 - p2
+	return (overround - 1.0) * (diff*diff - overround) / (overround * (diff*diff - 1.0))
+}
+
+// OddsRatioOddsWithOptions implements OddsRatioOdds with the given solver options,
+// returning the fitted c along with the fair odds.
+func OddsRatioOddsWithOptions(options DevigOptions, odds ...Odds) DevigResult {
+	return withOptions(oddsRatioInto, options, odds)
+}
+
+// LogarithmicOddsWithOptions implements LogarithmicOdds with the given solver
+// options, returning the fitted power c along with the fair odds.
+func LogarithmicOddsWithOptions(options DevigOptions, odds ...Odds) DevigResult {
+	return withOptions(logarithmicInto, options, odds)
+}
+
+// ImpliedProbsInto writes the implied probability, as a decimal, of each of odds
+// into dst, which must be at least as long, and returns dst[:len(odds)].
+func ImpliedProbsInto(dst []float64, odds ...Odds) []float64 {
+	dst = dst[:len(odds)]
+	for i, o := range odds {
+		dst[i] = 1 / o.decimalOdds
+	}
+	return dst
+}
+
+// DevigInto writes the fair probabilities, as decimals, of the market with the
+// given implied probabilities, as decimals such as from ImpliedProbsInto, into
+// fair using method with the default solver options, and returns fair[:len(implied)],
+// or nil for an unknown method. fair must be at least as long as implied, and may
+// share its memory to devig in place. Unlike DevigProbs it does not allocate, for
+// devigging large batches of markets into reused buffers.
+func DevigInto(method DevigMethod, fair, implied []float64) []float64 {
+	fair = fair[:len(implied)]
+	switch method {
+	case Multiplicative:
+		multiplicativeInto(fair, implied)
+	case Additive:
+		additiveInto(fair, implied)
+	case MPTO:
+		mptoInto(fair, implied)
+	case Shin:
+		shinInto(DevigOptions{}, fair, implied)
+	case OddsRatio:
+		oddsRatioInto(DevigOptions{}, fair, implied)
+	case Logarithmic:
+		logarithmicInto(DevigOptions{}, fair, implied)
+	default:
+		return nil
+	}
+	return fair
+}
+
+// maxStackOutcomes is the most outcomes DevigOddsInto devigs without allocating.
+const maxStackOutcomes = 16
+
+// DevigOddsInto writes the fair odds of the market at the given odds using method
+// into dst, which must be at least as long, and returns dst[:len(odds)], or nil for
+// an unknown method. Markets of up to 16 outcomes are devigged without allocating.
+func DevigOddsInto(method DevigMethod, dst []Odds, odds ...Odds) []Odds {
+	var buf [maxStackOutcomes]float64
+	var fair []float64
+	if len(odds) <= maxStackOutcomes {
+		fair = buf[:len(odds)]
+	} else {
+		fair = make([]float64, len(odds))
+	}
+	if DevigInto(method, fair, ImpliedProbsInto(fair, odds...)) == nil {
+		return nil
+	}
+	dst = dst[:len(odds)]
+	for i, p := range fair {
+		dst[i] = NewOddsFromDecimal(1.0 / p)
+	}
+	return dst
+}
+
+// devigOdds returns the fair odds of the market at the given odds using method.
+func devigOdds(method DevigMethod, odds []Odds) []Odds {
+	return DevigOddsInto(method, make([]Odds, len(odds)), odds...)
+}
+
+// devigProbs returns the fair probabilities of the market at the given odds using
+// method.
+func devigProbs(method DevigMethod, odds []Odds) []Probability {
+	fair := DevigInto(method, make([]float64, len(odds)), ImpliedProbsInto(make([]float64, len(odds)), odds...))
+	if fair == nil {
+		return nil
+	}
+	probs := make([]Probability, len(fair))
+	for i, p := range fair {
+		probs[i] = NewProbabilityFromDecimal(p)
+	}
+	return probs
+}
+
+// EqualMarginProbs returns the fair probabilities of EqualMarginOdds.
+func EqualMarginProbs(odds ...Odds) []Probability {
+	return devigProbs(Multiplicative, odds)
+}
+
+// AdditiveProbs returns the fair probabilities of AdditiveOdds.
+func AdditiveProbs(odds ...Odds) []Probability {
+	return devigProbs(Additive, odds)
+}
+
+// MPTProbs returns the fair probabilities of MPTOdds.
+func MPTProbs(odds ...Odds) []Probability {
+	return devigProbs(MPTO, odds)
+}
+
+// ShinProbs returns the fair probabilities of ShinOdds.
+func ShinProbs(odds ...Odds) []Probability {
+	return devigProbs(Shin, odds)
+}
+
+// OddsRatioProbs returns the fair probabilities of OddsRatioOdds.
+func OddsRatioProbs(odds ...Odds) []Probability {
+	return devigProbs(OddsRatio, odds)
+}
+
+// LogarithmicProbs returns the fair probabilities of LogarithmicOdds.
+func LogarithmicProbs(odds ...Odds) []Probability {
+	return devigProbs(Logarithmic, odds)
+}
+
+// DevigMethod is a method of removing the margin from a market.
+type DevigMethod int
+
+const (
+	// Multiplicative is the method of EqualMarginOdds.
+	Multiplicative DevigMethod = iota
+	// Additive is the method of AdditiveOdds.
+	Additive
+	// MPTO is the method of MPTOdds.
+	MPTO
+	// Shin is the method of ShinOdds.
+	Shin
+	// OddsRatio is the method of OddsRatioOdds.
+	OddsRatio
+	// Logarithmic is the method of LogarithmicOdds.
+	Logarithmic
+)
+
+// Power is the power method, another name for Logarithmic, which parses from
+// "power".
+const Power = Logarithmic
+
+// devigMethods holds every DevigMethod.
+var devigMethods = []DevigMethod{Multiplicative, Additive, MPTO, Shin, OddsRatio, Logarithmic}
+
+// devigAliases maps other names of methods to the method.
+var devigAliases = map[string]DevigMethod{"power": Power}
+
+// String returns the name of the method.
+func (dm DevigMethod) String() string {
+	switch dm {
+	case Multiplicative:
+		return "multiplicative"
+	case Additive:
+		return "additive"
+	case MPTO:
+		return "mpto"
+	case Shin:
+		return "shin"
+	case OddsRatio:
+		return "odds ratio"
+	case Logarithmic:
+		return "logarithmic"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDevigMethod returns the DevigMethod with the given name, as returned by
+// String or another name such as "power", so that the method can be a
+// configuration value.
+func ParseDevigMethod(s string) (DevigMethod, error) {
+	for _, dm := range devigMethods {
+		if s == dm.String() {
+			return dm, nil
+		}
+	}
+	if dm, ok := devigAliases[s]; ok {
+		return dm, nil
+	}
+	return Multiplicative, fmt.Errorf("unknown devig method %q", s)
+}
+
+// Devig returns the fair odds of the market at the given odds using method, or nil
+// for an unknown method. See DevigOddsInto to devig into a reused slice.
+func Devig(method DevigMethod, odds ...Odds) []Odds {
+	return devigOdds(method, odds)
+}
+
+// DevigProbs returns the fair probabilities of the market at the given odds using
+// method, or nil for an unknown method. See DevigInto to devig into reused buffers.
+func DevigProbs(method DevigMethod, odds ...Odds) []Probability {
+	return devigProbs(method, odds)
+}
+
+// ConsensusFair returns the consensus fair probability of each outcome of a market
+// quoted at several books, given as a map from book to the odds of each outcome in
+// the same order. Each book's quotes are devigged with method and the fair
+// probabilities averaged, weighted by weights, a map from book to its weight, such
+// as higher for sharper books. A nil weights weights every book equally, otherwise
+// books without a weight are ignored. An error is returned when the books quote
+// differing numbers of outcomes or the total weight is not positive.
+func ConsensusFair(method DevigMethod, books map[string][]Odds, weights map[string]float64) ([]Probability, error) {
+	var names []string
+	for b := range books {
+		names = append(names, b)
+	}
+	// Sort for a deterministic order of summation.
+	sort.Strings(names)
+	var sums []float64
+	totalWeight := 0.0
+	for _, b := range names {
+		weight := 1.0
+		if weights != nil {
+			weight = weights[b]
+		}
+		if weight == 0.0 {
+			continue
+		}
+		odds := books[b]
+		if sums == nil {
+			sums = make([]float64, len(odds))
+		} else if len(odds) != len(sums) {
+			return nil, fmt.Errorf("book %s quotes %d outcomes, expected %d", b, len(odds), len(sums))
+		}
+		for i, p := range DevigProbs(method, odds...) {
+			sums[i] += weight * p.decimal
+		}
+		totalWeight += weight
+	}
+	if totalWeight <= 0.0 {
+		return nil, fmt.Errorf("total weight %v is not positive", totalWeight)
+	}
+	var fair []Probability
+	for _, s := range sums {
+		fair = append(fair, NewProbabilityFromDecimal(s/totalWeight))
+	}
+	return fair, nil
+}
+
+// WorstCaseFair returns, for each outcome of the market at the given odds, the
+// least favorable fair probability to a bettor backing it, the lowest, across the
+// given devig methods, or across every method when none are given. Expected values
+// against the result are robust to the choice of method. The probabilities
+// generally sum to less than one.
+func WorstCaseFair(odds []Odds, methods ...DevigMethod) []Probability {
+	if len(methods) == 0 {
+		methods = devigMethods
+	}
+	var worst []Probability
+	for _, m := range methods {
+		for i, p := range DevigProbs(m, odds...) {
+			if i == len(worst) {
+				worst = append(worst, p)
+			} else if p.decimal < worst[i].decimal {
+				worst[i] = p
+			}
+		}
+	}
+	return worst
+}
+
+// NoVigLine returns the fair odds of each side of a two way market at the given
+// odds, and the fair probability of the first side, removing the margin with the
+// multiplicative method.
+func NoVigLine(odds1, odds2 Odds) (fair1, fair2 Odds, prob1 Probability) {
+	probs := EqualMarginProbs(odds1, odds2)
+	fair1 = NewOddsFromDecimal(1.0 / probs[0].decimal)
+	fair2 = NewOddsFromDecimal(1.0 / probs[1].decimal)
+	return fair1, fair2, probs[0]
+}
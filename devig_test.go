@@ -0,0 +1,283 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestShinOddsWithOptions(t *testing.T) {
+	result := ShinOddsWithOptions(DevigOptions{}, sampleOdds2()...)
+	assert.Equal(t, ShinOdds(sampleOdds2()...), result.Odds)
+	assert.InDelta(t, 0.0169, result.Param, 0.0001)
+	assert.Greater(t, result.Iterations, 1)
+	assert.Less(t, result.Residual, 1e-9)
+	assert.True(t, result.Converged)
+	assert.NoError(t, result.Err())
+
+	result = ShinOddsWithOptions(DevigOptions{}, NewOddsFromDecimal(1.8), NewOddsFromDecimal(2.1))
+	assert.Equal(t, 0, result.Iterations)
+	assert.True(t, result.Converged)
+	assert.InDelta(t, 0.0318, result.Param, 0.0001)
+
+	result = ShinOddsWithOptions(DevigOptions{MaxIterations: 1}, sampleOdds2()...)
+	assert.Equal(t, 1, result.Iterations)
+	assert.Greater(t, result.Residual, 1e-9)
+	assert.False(t, result.Converged)
+	assert.ErrorIs(t, result.Err(), ErrNotConverged)
+}
+
+func TestOddsRatioOddsWithOptions(t *testing.T) {
+	result := OddsRatioOddsWithOptions(DevigOptions{Tolerance: 1e-6}, sampleOdds1()...)
+	assert.Less(t, result.Residual, 1e-5)
+	assert.InDelta(t, 1.0, result.Param, 0.1)
+	assert.Equal(t, 2.1285, round(result.Odds[0].decimalOdds, 4))
+}
+
+func TestLogarithmicOddsWithOptions(t *testing.T) {
+	initial := LogarithmicOddsWithOptions(DevigOptions{}, sampleOdds1()...)
+	guessed := LogarithmicOddsWithOptions(DevigOptions{InitialGuess: initial.Param}, sampleOdds1()...)
+	assert.Less(t, guessed.Iterations, initial.Iterations)
+	assert.InDelta(t, initial.Param, guessed.Param, 1e-9)
+}
+
+func TestDevigWithOptions_HighMargin(t *testing.T) {
+	// A ten way market with a 28% margin and a heavy favorite.
+	odds := []Odds{NewOddsFromDecimal(1.25)}
+	for i := 0; i < 9; i++ {
+		odds = append(odds, NewOddsFromDecimal(8.0+4.0*float64(i)))
+	}
+	solvers := map[string]func(DevigOptions, ...Odds) DevigResult{
+		"shin":        ShinOddsWithOptions,
+		"odds ratio":  OddsRatioOddsWithOptions,
+		"logarithmic": LogarithmicOddsWithOptions,
+	}
+	for name, solve := range solvers {
+		result := solve(DevigOptions{}, odds...)
+		assert.NoError(t, result.Err(), name)
+		assert.Less(t, result.Iterations, 20, name)
+		assert.Less(t, result.Residual, 1e-12, name)
+
+		// A poor initial guess costs only a few more iterations.
+		result = solve(DevigOptions{InitialGuess: 0.9}, odds...)
+		assert.NoError(t, result.Err(), name)
+		assert.Less(t, result.Iterations, 30, name)
+	}
+}
+
+func TestShinOddsWithOptions_Underround(t *testing.T) {
+	// The best lines across books can sum to less than one, for a negative z.
+	odds := []Odds{NewOddsFromDecimal(2.7), NewOddsFromDecimal(3.6), NewOddsFromDecimal(3.4)}
+	result := ShinOddsWithOptions(DevigOptions{}, odds...)
+	assert.NoError(t, result.Err())
+	assert.Less(t, result.Param, 0.0)
+	assert.Less(t, result.Residual, 1e-12)
+
+	// A solver pinned against the edge of its bracket has not converged, however
+	// small its last step.
+	result = devigSolution{converged: true}.result(DevigOptions{}, []float64{0.32, 0.31, 0.31})
+	assert.InDelta(t, 0.06, result.Residual, 1e-12)
+	assert.ErrorIs(t, result.Err(), ErrNotConverged)
+}
+
+func TestDevigResult_Err(t *testing.T) {
+	// A market without margin leaves nothing for the odds ratio solver to fit.
+	result := OddsRatioOddsWithOptions(DevigOptions{}, NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0))
+	assert.NoError(t, result.Err())
+
+	result = LogarithmicOddsWithOptions(DevigOptions{MaxIterations: 1}, sampleOdds1()...)
+	assert.False(t, result.Converged)
+	assert.ErrorIs(t, result.Err(), ErrNotConverged)
+}
+
+func TestDevigProbs(t *testing.T) {
+	var methods = []struct {
+		name  string
+		odds  func(...Odds) []Odds
+		probs func(...Odds) []Probability
+	}{
+		{"equal margin", EqualMarginOdds, EqualMarginProbs},
+		{"additive", AdditiveOdds, AdditiveProbs},
+		{"mpt", MPTOdds, MPTProbs},
+		{"shin", ShinOdds, ShinProbs},
+		{"odds ratio", OddsRatioOdds, OddsRatioProbs},
+		{"logarithmic", LogarithmicOdds, LogarithmicProbs},
+	}
+	for _, m := range methods {
+		fairOdds := m.odds(sampleOdds1()...)
+		fairProbs := m.probs(sampleOdds1()...)
+		sum := 0.0
+		for i, p := range fairProbs {
+			assert.InDeltaf(t, fairOdds[i].ImpliedProb().decimal, p.decimal, 1e-12, "%s probability %d", m.name, i)
+			sum += p.decimal
+		}
+		assert.InDeltaf(t, 1.0, sum, 1e-9, "%s probability sum", m.name)
+	}
+}
+
+func TestDevig(t *testing.T) {
+	assert.Equal(t, ShinOdds(sampleOdds1()...), Devig(Shin, sampleOdds1()...))
+	assert.Equal(t, MPTOdds(sampleOdds1()...), Devig(MPTO, sampleOdds1()...))
+	assert.Nil(t, Devig(DevigMethod(-1), sampleOdds1()...))
+	assert.Nil(t, DevigProbs(DevigMethod(-1), sampleOdds1()...))
+}
+
+func TestParseDevigMethod(t *testing.T) {
+	for _, dm := range devigMethods {
+		parsed, err := ParseDevigMethod(dm.String())
+		assert.NoError(t, err)
+		assert.Equal(t, dm, parsed)
+	}
+	parsed, err := ParseDevigMethod("power")
+	assert.NoError(t, err)
+	assert.Equal(t, Logarithmic, parsed)
+	_, err = ParseDevigMethod("bogus")
+	assert.Error(t, err)
+}
+
+func TestConsensusFair(t *testing.T) {
+	books := map[string][]Odds{
+		"sharp":  {NewOddsFromDecimal(1.8), NewOddsFromDecimal(2.1)},
+		"retail": {NewOddsFromDecimal(2.0), NewOddsFromDecimal(1.8)},
+	}
+	sharp := EqualMarginProbs(books["sharp"]...)
+	retail := EqualMarginProbs(books["retail"]...)
+
+	fair, err := ConsensusFair(Multiplicative, books, nil)
+	assert.NoError(t, err)
+	assert.InDelta(t, (sharp[0].decimal+retail[0].decimal)/2.0, fair[0].decimal, 1e-12)
+	assert.InDelta(t, 1.0, fair[0].decimal+fair[1].decimal, 1e-12)
+
+	fair, err = ConsensusFair(Multiplicative, books, map[string]float64{"sharp": 3.0, "retail": 1.0})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.75*sharp[0].decimal+0.25*retail[0].decimal, fair[0].decimal, 1e-12)
+
+	fair, err = ConsensusFair(Multiplicative, books, map[string]float64{"sharp": 1.0})
+	assert.NoError(t, err)
+	assert.InDelta(t, sharp[1].decimal, fair[1].decimal, 1e-12)
+
+	_, err = ConsensusFair(Multiplicative, books, map[string]float64{"other": 1.0})
+	assert.Error(t, err)
+	books["three way"] = sampleOdds1()
+	_, err = ConsensusFair(Multiplicative, books, nil)
+	assert.Error(t, err)
+}
+
+func TestWorstCaseFair(t *testing.T) {
+	odds := sampleOdds1()
+	worst := WorstCaseFair(odds, Multiplicative, Additive)
+	multiplicative := EqualMarginProbs(odds...)
+	additive := AdditiveProbs(odds...)
+	for i := range odds {
+		assert.Equal(t, math.Min(multiplicative[i].decimal, additive[i].decimal), worst[i].decimal)
+	}
+	// The additive method shades the longshots more and the favorite less.
+	assert.Equal(t, multiplicative[0], worst[0])
+	assert.Equal(t, additive[2], worst[2])
+
+	worst = WorstCaseFair(odds)
+	sum := 0.0
+	for i, p := range worst {
+		for _, m := range devigMethods {
+			assert.LessOrEqual(t, p.decimal, DevigProbs(m, odds...)[i].decimal)
+		}
+		sum += p.decimal
+	}
+	assert.Less(t, sum, 1.0)
+}
+
+func TestNoVigLine(t *testing.T) {
+	fair1, fair2, prob1 := NoVigLine(NewOddsFromAmerican(-150.0), NewOddsFromAmerican(+130.0))
+	assert.InDelta(t, 0.5798, prob1.decimal, 0.0001)
+	assert.InDelta(t, -138.0, fair1.American(), 0.1)
+	assert.InDelta(t, 138.0, fair2.American(), 0.1)
+}
+
+func TestDevigInto(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(2.6), NewOddsFromDecimal(3.1), NewOddsFromDecimal(2.9)}
+	implied := ImpliedProbsInto(make([]float64, 8), odds...)
+	assert.Len(t, implied, 3)
+	fair := make([]float64, 3)
+	for _, method := range devigMethods {
+		DevigInto(method, fair, implied)
+		for i, p := range DevigProbs(method, odds...) {
+			assert.Equal(t, p.decimal, fair[i], method.String())
+		}
+		assert.Equal(t, Devig(method, odds...), DevigOddsInto(method, make([]Odds, 3), odds...), method.String())
+	}
+
+	// Devigging in place overwrites the implied probabilities.
+	DevigInto(Multiplicative, implied, implied)
+	assert.InDelta(t, 1.0, total(implied), 1e-12)
+
+	assert.Nil(t, DevigInto(DevigMethod(-1), fair, implied))
+	assert.Nil(t, DevigOddsInto(DevigMethod(-1), make([]Odds, 3), odds...))
+}
+
+func TestDevigInto_Allocs(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(2.6), NewOddsFromDecimal(3.1), NewOddsFromDecimal(2.9)}
+	implied := make([]float64, len(odds))
+	fair := make([]float64, len(odds))
+	dst := make([]Odds, len(odds))
+	for _, method := range devigMethods {
+		allocs := testing.AllocsPerRun(100, func() {
+			DevigInto(method, fair, ImpliedProbsInto(implied, odds...))
+			DevigOddsInto(method, dst, odds...)
+		})
+		assert.Equal(t, 0.0, allocs, method.String())
+	}
+}
+
+// benchmarkMarkets returns n three way markets with varying margins.
+func benchmarkMarkets(n int) [][]Odds {
+	markets := make([][]Odds, n)
+	for i := range markets {
+		shift := float64(i%50) / 100.0
+		markets[i] = []Odds{NewOddsFromDecimal(2.4 + shift), NewOddsFromDecimal(3.3), NewOddsFromDecimal(3.0 - shift/2.0)}
+	}
+	return markets
+}
+
+func BenchmarkDevigProbs(b *testing.B) {
+	markets := benchmarkMarkets(10000)
+	for _, method := range devigMethods {
+		b.Run(method.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, m := range markets {
+					DevigProbs(method, m...)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDevigInto(b *testing.B) {
+	markets := benchmarkMarkets(10000)
+	buf := make([]float64, 3)
+	for _, method := range devigMethods {
+		b.Run(method.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, m := range markets {
+					DevigInto(method, buf, ImpliedProbsInto(buf, m...))
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDevigOddsInto(b *testing.B) {
+	markets := benchmarkMarkets(10000)
+	dst := make([]Odds, 3)
+	for _, method := range devigMethods {
+		b.Run(method.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, m := range markets {
+					DevigOddsInto(method, dst, m...)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package wagering
+
+// Dutch returns the stakes splitting totalStake across the selections at the given
+// odds so that the same profit is made whichever of the selections wins, and that
+// profit. The profit is negative, a loss, when the selections are collectively
+// priced to lose, and the whole of totalStake is lost if none of them win.
+func Dutch(odds []Odds, totalStake float64) ([]float64, float64) {
+	a := arb(totalStake, odds...)
+	return a.Stakes, a.Profit
+}
+
+// RoundedDutch returns the stakes of Dutch rounded to multiples of increment, as
+// accepted by books, without staking more than totalStake in all, the worst case
+// profit across the selections after rounding, and whether every selection is
+// still staked. See ArbitrageN for how each stake is rounded.
+func RoundedDutch(odds []Odds, totalStake, increment float64) ([]float64, float64, bool) {
+	stakes, profit := Dutch(odds, totalStake)
+	if increment <= 0.0 {
+		return stakes, profit, true
+	}
+	return roundStakes(stakes, odds, increment, totalStake)
+}
+
+// DutchForProfit returns the stakes across the selections at the given odds that
+// make targetProfit whichever of the selections wins, the profit made, and whether
+// the target is feasible. maxStakes, if not nil, holds the most that may be wagered
+// on each selection, such as a book limit. When the selections are collectively
+// priced to lose, or a limit binds, the target is infeasible and the stakes
+// returned are those making the largest profit possible within the limits. Zero
+// stakes are returned, as infeasible, if targetProfit is negative or maxStakes is
+// not nil and has a different length from odds.
+func DutchForProfit(odds []Odds, targetProfit float64, maxStakes []float64) ([]float64, float64, bool) {
+	s := probSum(odds...)
+	if s >= 1.0 || targetProfit < 0.0 || (maxStakes != nil && len(maxStakes) != len(odds)) {
+		stakes := make([]float64, len(odds))
+		return stakes, 0.0, false
+	}
+	// Every selection returns the same amount, ret, with ret - ret*s as profit.
+	ret := targetProfit / (1.0 - s)
+	ok := true
+	for i, o := range odds {
+		if maxStakes != nil && ret/o.decimalOdds > maxStakes[i] {
+			ret = maxStakes[i] * o.decimalOdds
+			ok = false
+		}
+	}
+	var stakes []float64
+	for _, o := range odds {
+		stakes = append(stakes, ret/o.decimalOdds)
+	}
+	return stakes, ret * (1.0 - s), ok
+}
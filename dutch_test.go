@@ -0,0 +1,78 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDutch(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(4.0), NewOddsFromDecimal(5.0), NewOddsFromDecimal(10.0)}
+	stakes, profit := Dutch(odds, 110.0)
+	assert.InDelta(t, 50.0, stakes[0], 1e-9)
+	assert.InDelta(t, 40.0, stakes[1], 1e-9)
+	assert.InDelta(t, 20.0, stakes[2], 1e-9)
+	assert.InDelta(t, 90.0, profit, 1e-9)
+	for i, o := range odds {
+		assert.InDelta(t, profit, stakes[i]*o.decimalOdds-110.0, 1e-9)
+	}
+
+	_, profit = Dutch([]Odds{NewOddsFromDecimal(1.5), NewOddsFromDecimal(2.5)}, 100.0)
+	assert.InDelta(t, -6.25, profit, 1e-9)
+}
+
+func TestDutchForProfit(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(4.0), NewOddsFromDecimal(5.0), NewOddsFromDecimal(10.0)}
+	stakes, profit, ok := DutchForProfit(odds, 45.0, nil)
+	assert.True(t, ok)
+	assert.InDelta(t, 45.0, profit, 1e-9)
+	assert.InDelta(t, 25.0, stakes[0], 1e-9)
+	assert.InDelta(t, 20.0, stakes[1], 1e-9)
+	assert.InDelta(t, 10.0, stakes[2], 1e-9)
+
+	stakes, profit, ok = DutchForProfit(odds, 45.0, []float64{100.0, 100.0, 5.0})
+	assert.False(t, ok)
+	assert.InDelta(t, 22.5, profit, 1e-9)
+	assert.InDelta(t, 12.5, stakes[0], 1e-9)
+	assert.InDelta(t, 10.0, stakes[1], 1e-9)
+	assert.InDelta(t, 5.0, stakes[2], 1e-9)
+
+	_, profit, ok = DutchForProfit([]Odds{NewOddsFromDecimal(1.5), NewOddsFromDecimal(2.5)}, 10.0, nil)
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, profit)
+
+	stakes, profit, ok = DutchForProfit(odds, 45.0, []float64{100.0, 100.0})
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, profit)
+	assert.Equal(t, []float64{0.0, 0.0, 0.0}, stakes)
+
+	stakes, profit, ok = DutchForProfit(odds, -45.0, nil)
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, profit)
+	assert.Equal(t, []float64{0.0, 0.0, 0.0}, stakes)
+}
+
+func TestRoundedDutch(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(2.2), NewOddsFromDecimal(1.98)}
+	// Rounding to the nearest 5, 45 and 55, would lose 1 if the second selection won.
+	stakes, profit, ok := RoundedDutch(odds, 100.0, 5.0)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{45.0, 50.0}, stakes)
+	assert.InDelta(t, 4.0, profit, 1e-9)
+
+	// Rounding each stake to the nearest 5, 50 and 55, would overspend 102.
+	stakes, profit, ok = RoundedDutch(odds, 102.0, 5.0)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{45.0, 50.0}, stakes)
+	assert.InDelta(t, 4.0, profit, 1e-9)
+
+	// There is no staking both selections in multiples of 5 with 8.
+	stakes, _, ok = RoundedDutch(odds, 8.0, 5.0)
+	assert.False(t, ok)
+	assert.Equal(t, []float64{0.0, 0.0}, stakes)
+
+	stakes, profit, ok = RoundedDutch(odds, 100.0, 0.0)
+	expected, expectedProfit := Dutch(odds, 100.0)
+	assert.True(t, ok)
+	assert.Equal(t, expected, stakes)
+	assert.Equal(t, expectedProfit, profit)
+}
@@ -0,0 +1,85 @@
+package wagering
+
+import (
+	"math"
+)
+
+// EloConfig configures an Elo rating system.
+type EloConfig struct {
+	// K is the most a rating can change in a single game.
+	K float64
+	// HomeAdvantage is the rating points added to the home side's rating when
+	// estimating its probability of winning.
+	HomeAdvantage float64
+	// Initial is the rating of sides not yet rated. Defaults to 1500 when zero.
+	Initial float64
+	// MarginOfVictory, when true, scales rating changes by the margin of victory,
+	// damped for heavy favorites winning big as in FiveThirtyEight's NFL model.
+	MarginOfVictory bool
+}
+
+// Elo is an Elo rating system producing win probabilities from the ratings of
+// sides updated with the results of their games.
+// https://en.wikipedia.org/wiki/Elo_rating_system
+type Elo struct {
+	config  EloConfig
+	ratings map[string]float64
+}
+
+// NewElo constructs a new Elo rating system with no sides rated.
+func NewElo(config EloConfig) Elo {
+	if config.Initial == 0.0 {
+		config.Initial = 1500.0
+	}
+	return Elo{config: config, ratings: make(map[string]float64)}
+}
+
+// Rating returns the rating of the side, the initial rating if not yet rated.
+func (e *Elo) Rating(side string) float64 {
+	if r, ok := e.ratings[side]; ok {
+		return r
+	}
+	return e.config.Initial
+}
+
+// SetRating sets the rating of the side, such as to seed it from a prior season.
+func (e *Elo) SetRating(side string, rating float64) {
+	e.ratings[side] = rating
+}
+
+// eloExpected returns the expected score, the probability of winning counting a
+// draw as half, of a side rated diff points above its opponent.
+func eloExpected(diff float64) float64 {
+	return 1.0 / (1.0 + math.Pow(10.0, -diff/400.0))
+}
+
+// WinProb returns the probability, counting a draw as half a win, of the home side
+// beating the away side.
+func (e *Elo) WinProb(home, away string) Probability {
+	return NewProbabilityFromDecimal(eloExpected(e.Rating(home) + e.config.HomeAdvantage - e.Rating(away)))
+}
+
+// Update updates the ratings of the home and away sides with the final score of a
+// game between them.
+// https://fivethirtyeight.com/methodology/how-our-nfl-predictions-work/
+func (e *Elo) Update(home, away string, homeScore, awayScore float64) {
+	diff := e.Rating(home) + e.config.HomeAdvantage - e.Rating(away)
+	expected := eloExpected(diff)
+	actual := 0.5
+	if homeScore > awayScore {
+		actual = 1.0
+	} else if homeScore < awayScore {
+		actual = 0.0
+	}
+	mult := 1.0
+	if e.config.MarginOfVictory && homeScore != awayScore {
+		winnerDiff := diff
+		if homeScore < awayScore {
+			winnerDiff = -diff
+		}
+		mult = math.Log(math.Abs(homeScore-awayScore)+1.0) * 2.2 / (winnerDiff*0.001 + 2.2)
+	}
+	change := e.config.K * mult * (actual - expected)
+	e.ratings[home] = e.Rating(home) + change
+	e.ratings[away] = e.Rating(away) - change
+}
@@ -0,0 +1,45 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestElo(t *testing.T) {
+	e := NewElo(EloConfig{K: 20.0})
+	assert.Equal(t, 1500.0, e.Rating("NE"))
+	assert.InDelta(t, 0.5, e.WinProb("NE", "NYJ").Decimal(), 1e-12)
+
+	e.Update("NE", "NYJ", 24.0, 17.0)
+	assert.InDelta(t, 1510.0, e.Rating("NE"), 1e-9)
+	assert.InDelta(t, 1490.0, e.Rating("NYJ"), 1e-9)
+
+	e.Update("NE", "NYJ", 10.0, 10.0)
+	assert.Less(t, e.Rating("NE"), 1510.0)
+	assert.InDelta(t, 3000.0, e.Rating("NE")+e.Rating("NYJ"), 1e-9)
+
+	e.SetRating("KC", 1700.0)
+	assert.InDelta(t, 0.7597, e.WinProb("KC", "NE").Decimal(), 0.01)
+}
+
+func TestElo_HomeAdvantage(t *testing.T) {
+	e := NewElo(EloConfig{K: 20.0, HomeAdvantage: 65.0, Initial: 1600.0})
+	assert.Equal(t, 1600.0, e.Rating("SEA"))
+	prob := e.WinProb("SEA", "SF")
+	assert.InDelta(t, 0.5925, prob.Decimal(), 0.0001)
+	// The probability plugs straight into the pricing functions.
+	assert.Greater(t, NewOddsFromDecimal(2.0).KellyFraction(prob, 1.0), 0.0)
+}
+
+func TestElo_MarginOfVictory(t *testing.T) {
+	e := NewElo(EloConfig{K: 20.0, MarginOfVictory: true})
+	e.Update("NE", "NYJ", 38.0, 3.0)
+	blowout := e.Rating("NE") - 1500.0
+
+	e = NewElo(EloConfig{K: 20.0, MarginOfVictory: true})
+	e.Update("NE", "NYJ", 20.0, 17.0)
+	narrow := e.Rating("NE") - 1500.0
+	assert.Greater(t, blowout, narrow)
+	// ln(4) * 10.
+	assert.InDelta(t, 13.86, narrow, 0.01)
+}
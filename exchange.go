@@ -0,0 +1,134 @@
+package wagering
+
+import (
+	"math"
+)
+
+// Commission on exchanges, such as Betfair, is charged on net winnings and is given
+// as a decimal, for example 0.02 for 2%.
+
+// NetOfCommission returns the effective odds of backing at odds when the given
+// commission is charged on winnings.
+func (odds Odds) NetOfCommission(commission float64) Odds {
+	return NewOddsFromDecimal(1.0 + (odds.decimalOdds-1.0)*(1.0-commission))
+}
+
+// LayLiability returns the amount lost when laying a backer's stake at odds and the
+// selection wins.
+func (odds Odds) LayLiability(stake float64) float64 {
+	return stake * (odds.decimalOdds - 1.0)
+}
+
+// LayProfit returns the amount won, after the given commission, when laying a
+// backer's stake at odds and the selection loses.
+func (odds Odds) LayProfit(stake, commission float64) float64 {
+	return stake * (1.0 - commission)
+}
+
+// LayOdds returns the effective odds of laying at odds, after the given
+// commission, viewed as a back bet on the selection losing with the liability as
+// the stake.
+func (odds Odds) LayOdds(commission float64) Odds {
+	return NewOddsFromDecimal(1.0 + (1.0-commission)/(odds.decimalOdds-1.0))
+}
+
+// MatchedBetMode is the kind of back bet placed in a matched bet.
+type MatchedBetMode int
+
+const (
+	// Qualifying is a normal, cash, back bet.
+	Qualifying MatchedBetMode = iota
+	// FreeBetSNR is a free back bet where the stake is not returned on a win.
+	FreeBetSNR
+	// FreeBetSR is a free back bet where the stake is returned on a win.
+	FreeBetSR
+)
+
+// MatchedBet is a back bet at a book matched with a lay of the same selection at
+// an exchange, sized so that the result is the same whichever way the selection
+// goes.
+type MatchedBet struct {
+	mode       MatchedBetMode
+	backStake  float64
+	backOdds   Odds
+	layOdds    Odds
+	commission float64
+}
+
+// NewMatchedBet constructs a new MatchedBet backing backStake at backOdds and
+// laying at layOdds on an exchange charging the given commission.
+func NewMatchedBet(mode MatchedBetMode, backStake float64, backOdds, layOdds Odds, commission float64) MatchedBet {
+	return MatchedBet{mode: mode, backStake: backStake, backOdds: backOdds, layOdds: layOdds, commission: commission}
+}
+
+// LayStake returns the backer's stake to lay so that the result is the same
+// whichever way the selection goes.
+func (mb MatchedBet) LayStake() float64 {
+	backReturn := mb.backStake * mb.backOdds.decimalOdds
+	if mb.mode == FreeBetSNR {
+		backReturn -= mb.backStake
+	}
+	return backReturn / (mb.layOdds.decimalOdds - mb.commission)
+}
+
+// Liability returns the amount lost on the lay when the selection wins.
+func (mb MatchedBet) Liability() float64 {
+	return mb.layOdds.LayLiability(mb.LayStake())
+}
+
+// BackWinProfit returns the overall profit when the selection wins.
+func (mb MatchedBet) BackWinProfit() float64 {
+	profit := mb.backStake*(mb.backOdds.decimalOdds-1.0) - mb.Liability()
+	if mb.mode == FreeBetSR {
+		profit += mb.backStake
+	}
+	return profit
+}
+
+// LayWinProfit returns the overall profit when the selection loses.
+func (mb MatchedBet) LayWinProfit() float64 {
+	profit := mb.layOdds.LayProfit(mb.LayStake(), mb.commission)
+	if mb.mode == Qualifying {
+		profit -= mb.backStake
+	}
+	return profit
+}
+
+// Profit returns the overall profit of the matched bet, the lesser of the profits
+// of the two outcomes. For a qualifying bet this is typically negative, the
+// qualifying loss, and for a free bet it is the amount the free bet was converted
+// to.
+func (mb MatchedBet) Profit() float64 {
+	return math.Min(mb.BackWinProfit(), mb.LayWinProfit())
+}
+
+// KellyFractionWithCommission returns the fraction of the bankroll to back at odds
+// on an exchange charging the given commission on winnings, given the probability
+// for success and kelly multiplier. Net profit per unit staked is reduced to
+// (d - 1) * (1 - commission), so KellyFraction at the raw odds overbets.
+func (odds Odds) KellyFractionWithCommission(prob Probability, mult, commission float64) float64 {
+	return odds.NetOfCommission(commission).KellyFraction(prob, mult)
+}
+
+// KellyStakeWithCommission returns the amount to back at odds on an exchange
+// charging the given commission on winnings, given the probability for success,
+// kelly multiplier, and total bankroll.
+func (odds Odds) KellyStakeWithCommission(prob Probability, mult, commission, bankroll float64) float64 {
+	return odds.KellyFractionWithCommission(prob, mult, commission) * bankroll
+}
+
+// LayKellyFraction returns the fraction of the bankroll to risk as liability when
+// laying at odds on an exchange charging the given commission on winnings, given the
+// probability that the selection loses and kelly multiplier. The lay is treated as
+// a back bet on the selection losing at LayOdds.
+func (odds Odds) LayKellyFraction(loseProb Probability, mult, commission float64) float64 {
+	return odds.LayOdds(commission).KellyFraction(loseProb, mult)
+}
+
+// LayKellyStake returns the backer's stake to lay at odds on an exchange charging
+// the given commission on winnings, given the probability that the selection loses,
+// kelly multiplier, and total bankroll. The liability of the lay is the
+// LayKellyFraction of the bankroll.
+func (odds Odds) LayKellyStake(loseProb Probability, mult, commission, bankroll float64) float64 {
+	return odds.LayKellyFraction(loseProb, mult, commission) * bankroll / (odds.decimalOdds - 1.0)
+}
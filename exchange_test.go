@@ -0,0 +1,73 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOdds_NetOfCommission(t *testing.T) {
+	odds := NewOddsFromDecimal(3.0)
+	assert.InDelta(t, 2.96, odds.NetOfCommission(0.02).decimalOdds, 1e-9)
+	assert.InDelta(t, 2.90, odds.NetOfCommission(0.05).decimalOdds, 1e-9)
+	assert.Equal(t, 3.0, odds.NetOfCommission(0.0).decimalOdds)
+}
+
+func TestOdds_LayLiability(t *testing.T) {
+	assert.InDelta(t, 200.0, NewOddsFromDecimal(3.0).LayLiability(100.0), 1e-9)
+	assert.InDelta(t, 25.0, NewOddsFromDecimal(1.25).LayLiability(100.0), 1e-9)
+}
+
+func TestOdds_LayProfit(t *testing.T) {
+	assert.InDelta(t, 95.0, NewOddsFromDecimal(3.0).LayProfit(100.0, 0.05), 1e-9)
+}
+
+func TestOdds_LayOdds(t *testing.T) {
+	odds := NewOddsFromDecimal(3.0)
+	assert.InDelta(t, 1.5, odds.LayOdds(0.0).decimalOdds, 1e-9)
+	assert.InDelta(t, 1.49, odds.LayOdds(0.02).decimalOdds, 1e-9)
+	// Risking the liability at the lay odds returns the liability plus profit.
+	assert.InDelta(t, odds.LayLiability(100.0)+odds.LayProfit(100.0, 0.02), odds.LayLiability(100.0)*odds.LayOdds(0.02).decimalOdds, 1e-9)
+}
+
+func TestMatchedBet(t *testing.T) {
+	var expectedBets = []struct {
+		mode      MatchedBetMode
+		layStake  float64
+		liability float64
+		profit    float64
+	}{
+		{Qualifying, 10.17, 20.34, -0.34},
+		{FreeBetSNR, 6.78, 13.56, 6.44},
+		{FreeBetSR, 10.17, 20.34, 9.66},
+	}
+	for _, eb := range expectedBets {
+		mb := NewMatchedBet(eb.mode, 10.0, NewOddsFromDecimal(3.0), NewOddsFromDecimal(3.0), 0.05)
+		assert.InDelta(t, eb.layStake, mb.LayStake(), 0.01, "lay stake for mode %v", eb.mode)
+		assert.InDelta(t, eb.liability, mb.Liability(), 0.01, "liability for mode %v", eb.mode)
+		assert.InDelta(t, eb.profit, mb.Profit(), 0.01, "profit for mode %v", eb.mode)
+		assert.InDelta(t, mb.BackWinProfit(), mb.LayWinProfit(), 1e-9, "outcomes for mode %v", eb.mode)
+	}
+}
+
+func TestOdds_KellyFractionWithCommission(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	assert.InDelta(t, odds.KellyFraction(prob, 1.0), odds.KellyFractionWithCommission(prob, 1.0, 0.0), 1e-9)
+	// Net odds of 1.95: (0.95 * 0.6 - 0.4) / 0.95.
+	assert.InDelta(t, 0.1789, odds.KellyFractionWithCommission(prob, 1.0, 0.05), 0.0001)
+	assert.InDelta(t, 89.47, odds.KellyStakeWithCommission(prob, 0.5, 0.05, 1000.0), 0.01)
+	// Commission can remove the edge entirely.
+	assert.Equal(t, 0.0, NewOddsFromDecimal(2.0).KellyFractionWithCommission(NewProbabilityFromDecimal(0.51), 1.0, 0.05))
+}
+
+func TestOdds_LayKellyFraction(t *testing.T) {
+	// Laying at 3.0 is backing the selection losing at 1.5.
+	odds := NewOddsFromDecimal(3.0)
+	loseProb := NewProbabilityFromDecimal(0.75)
+	assert.InDelta(t, NewOddsFromDecimal(1.5).KellyFraction(loseProb, 1.0), odds.LayKellyFraction(loseProb, 1.0, 0.0), 1e-9)
+	assert.InDelta(t, 0.25, odds.LayKellyFraction(loseProb, 1.0, 0.0), 1e-9)
+	assert.Less(t, odds.LayKellyFraction(loseProb, 1.0, 0.05), 0.25)
+	// A liability of 250 on a 1000 bankroll lays a backer's stake of 125.
+	assert.InDelta(t, 125.0, odds.LayKellyStake(loseProb, 1.0, 0.0, 1000.0), 1e-9)
+	assert.Equal(t, 0.0, odds.LayKellyFraction(NewProbabilityFromDecimal(0.6), 1.0, 0.0))
+}
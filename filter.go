@@ -0,0 +1,85 @@
+package wagering
+
+// Candidate is a wager that a Filter can be applied to, such as a Play or a
+// LedgerEntry.
+type Candidate interface {
+	// candidate returns the odds of the wager and the estimated probability of it
+	// winning, if known.
+	candidate() (odds Odds, prob Probability, known bool)
+}
+
+func (p Play) candidate() (Odds, Probability, bool) {
+	return p.Odds, p.Fair, true
+}
+
+// candidate estimates the probability of the entry winning as that implied by its
+// closing odds, consistent with its closing line value.
+func (e LedgerEntry) candidate() (Odds, Probability, bool) {
+	return e.Bet.Odds(), e.Closing.ImpliedProb(), e.hasClosing()
+}
+
+// Filter is a predicate selecting candidate wagers, such as plays from a Scanner or
+// the entries of a Ledger.
+type Filter func(c Candidate) bool
+
+// All returns the Filter selecting candidates selected by every one of filters.
+func All(filters ...Filter) Filter {
+	return func(c Candidate) bool {
+		for _, f := range filters {
+			if !f(c) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MinEdge returns the Filter selecting candidates with an expected value, as a
+// decimal such as 0.02 for 2%, of at least ev. Candidates without an estimated
+// probability are not selected.
+func MinEdge(ev float64) Filter {
+	return func(c Candidate) bool {
+		odds, prob, known := c.candidate()
+		return known && odds.ExpectedValueProb(prob) >= ev
+	}
+}
+
+// MinOdds returns the Filter selecting candidates at odds at least as long as
+// odds, such as -150.
+func MinOdds(odds Odds) Filter {
+	return func(c Candidate) bool {
+		o, _, _ := c.candidate()
+		return o.decimalOdds >= odds.decimalOdds
+	}
+}
+
+// MaxOdds returns the Filter selecting candidates at odds at most as long as odds,
+// such as +150.
+func MaxOdds(odds Odds) Filter {
+	return func(c Candidate) bool {
+		o, _, _ := c.candidate()
+		return o.decimalOdds <= odds.decimalOdds
+	}
+}
+
+// MinKellyFraction returns the Filter selecting candidates with a full Kelly
+// fraction of at least fraction. Candidates without an estimated probability are
+// not selected.
+func MinKellyFraction(fraction float64) Filter {
+	return func(c Candidate) bool {
+		odds, prob, known := c.candidate()
+		return known && odds.KellyFraction(prob, 1.0) >= fraction
+	}
+}
+
+// Filter returns a new Ledger of the entries of l selected by every one of filters.
+func (l *Ledger) Filter(filters ...Filter) Ledger {
+	filter := All(filters...)
+	filtered := NewLedger()
+	for _, e := range l.entries {
+		if filter(e) {
+			filtered.Add(e)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,43 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFilters(t *testing.T) {
+	fair := NewProbabilityFromDecimal(0.5)
+	play := Play{Odds: NewOddsFromAmerican(+110.0), Fair: fair}
+	assert.True(t, MinEdge(0.05)(play))
+	assert.False(t, MinEdge(0.06)(play))
+	assert.True(t, MinOdds(NewOddsFromAmerican(-150.0))(play))
+	assert.False(t, MaxOdds(NewOddsFromAmerican(+100.0))(play))
+	assert.True(t, MinKellyFraction(0.04)(play))
+	assert.False(t, MinKellyFraction(0.05)(play))
+
+	between := All(MinEdge(0.02), MinOdds(NewOddsFromAmerican(-150.0)), MaxOdds(NewOddsFromAmerican(+150.0)))
+	assert.True(t, between(play))
+	assert.False(t, between(Play{Odds: NewOddsFromAmerican(+200.0), Fair: fair}))
+	assert.True(t, All()(play))
+}
+
+func TestScanner_Filters(t *testing.T) {
+	fair := sampleMarket("pinnacle", -150.0, +130.0).Devig(Multiplicative)
+	scanner := Scanner{Filters: []Filter{MaxOdds(NewOddsFromAmerican(+140.0))}}
+	plays := scanner.Scan(fair, sampleMarket("bookA", -140.0, +150.0), sampleMarket("bookB", -130.0, +145.0))
+	assert.Len(t, plays, 1)
+	assert.Equal(t, "bookB", plays[0].Book)
+	assert.Equal(t, "NE", plays[0].Outcome)
+}
+
+func TestLedger_Filter(t *testing.T) {
+	l := dummyLedger()
+	// Only the first entry beat its closing odds, the last has none.
+	filtered := l.Filter(MinEdge(0.01))
+	assert.Equal(t, 1, filtered.Len())
+	assert.Equal(t, l.Entries()[0], filtered.Entries()[0])
+
+	filtered = l.Filter(MaxOdds(NewOddsFromDecimal(2.5)), MinOdds(NewOddsFromDecimal(2.1)))
+	assert.Equal(t, 1, filtered.Len())
+	assert.Equal(t, 4, l.Len())
+}
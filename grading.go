@@ -0,0 +1,21 @@
+package wagering
+
+// GradeSpread returns the result and total return, including stake, of a point
+// spread wager of stake at odds on a team getting line points, for example -3.5 for
+// a favorite, given the final scores of the team and its opponent.
+func GradeSpread(line float64, odds Odds, stake, score, opponentScore float64) (Result, float64) {
+	result := lineResult(line, score-opponentScore)
+	return result, stake * settledOdds(odds, result)
+}
+
+// GradeTotal returns the result and total return, including stake, of a totals
+// wager of stake at odds on the over, or the under if over is false, of line given
+// the final scores of the two teams.
+func GradeTotal(line float64, over bool, odds Odds, stake, score1, score2 float64) (Result, float64) {
+	margin := score1 + score2 - line
+	if !over {
+		margin = -margin
+	}
+	result := lineResult(0.0, margin)
+	return result, stake * settledOdds(odds, result)
+}
@@ -0,0 +1,43 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGradeSpread(t *testing.T) {
+	var expectedGrades = []struct {
+		line          float64
+		score         float64
+		opponentScore float64
+		result        Result
+		payout        float64
+	}{
+		{-3.5, 24.0, 20.0, Win, 190.91},
+		{-3.5, 23.0, 20.0, Loss, 0.0},
+		{-3.0, 23.0, 20.0, Push, 100.0},
+		{+3.0, 20.0, 23.0, Push, 100.0},
+		{+7.5, 14.0, 21.0, Win, 190.91},
+	}
+	odds := NewOddsFromAmerican(-110.0)
+	for _, eg := range expectedGrades {
+		result, payout := GradeSpread(eg.line, odds, 100.0, eg.score, eg.opponentScore)
+		assert.Equal(t, eg.result, result, "spread %v with score %v-%v", eg.line, eg.score, eg.opponentScore)
+		assert.InDelta(t, eg.payout, payout, 0.01, "spread %v with score %v-%v", eg.line, eg.score, eg.opponentScore)
+	}
+}
+
+func TestGradeTotal(t *testing.T) {
+	odds := NewOddsFromAmerican(-110.0)
+	result, payout := GradeTotal(44.5, true, odds, 100.0, 24.0, 21.0)
+	assert.Equal(t, Win, result)
+	assert.InDelta(t, 190.91, payout, 0.01)
+
+	result, payout = GradeTotal(44.5, false, odds, 100.0, 24.0, 21.0)
+	assert.Equal(t, Loss, result)
+	assert.Equal(t, 0.0, payout)
+
+	result, payout = GradeTotal(45.0, false, odds, 100.0, 24.0, 21.0)
+	assert.Equal(t, Push, result)
+	assert.Equal(t, 100.0, payout)
+}
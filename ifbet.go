@@ -0,0 +1,145 @@
+package wagering
+
+// IfBet is a chain of straight wagers where each wager is placed only if the
+// previous wager wins, for an if-win bet, or wins or pushes, for an if-action
+// bet. A reverse is the set of if-bets over every ordering of its legs.
+type IfBet struct {
+	legs    []Leg
+	stake   float64
+	action  bool
+	reverse bool
+}
+
+// NewIfWinBet constructs a new if-win IfBet risking stake on each of the legs.
+func NewIfWinBet(stake float64, legs ...Leg) IfBet {
+	return IfBet{legs: legs, stake: stake}
+}
+
+// NewIfActionBet constructs a new if-action IfBet risking stake on each of the legs.
+func NewIfActionBet(stake float64, legs ...Leg) IfBet {
+	return IfBet{legs: legs, stake: stake, action: true}
+}
+
+// NewWinReverse constructs a new win reverse IfBet risking stake on each of the
+// legs of each of the if-win bets.
+func NewWinReverse(stake float64, legs ...Leg) IfBet {
+	return IfBet{legs: legs, stake: stake, reverse: true}
+}
+
+// NewActionReverse constructs a new action reverse IfBet risking stake on each of
+// the legs of each of the if-action bets.
+func NewActionReverse(stake float64, legs ...Leg) IfBet {
+	return IfBet{legs: legs, stake: stake, action: true, reverse: true}
+}
+
+// permutations returns every ordering of the indices [0, n).
+func permutations(n int) [][]int {
+	if n == 0 {
+		return [][]int{{}}
+	}
+	var perms [][]int
+	for _, perm := range permutations(n - 1) {
+		for i := 0; i <= len(perm); i++ {
+			p := make([]int, 0, n)
+			p = append(p, perm[:i]...)
+			p = append(p, n-1)
+			p = append(p, perm[i:]...)
+			perms = append(perms, p)
+		}
+	}
+	return perms
+}
+
+// chainProfit returns the profit of a single if-bet over the legs in the given
+// order with the given results, indexed by leg.
+func (ib IfBet) chainProfit(order []int, results []Result) float64 {
+	profit := 0.0
+	for _, i := range order {
+		switch results[i] {
+		case Win:
+			profit += ib.stake * (ib.legs[i].Odds.decimalOdds - 1.0)
+		case Loss:
+			return profit - ib.stake
+		case Push:
+			if !ib.action {
+				return profit
+			}
+		}
+	}
+	return profit
+}
+
+// Profit returns the net profit of the bet given the results of each leg.
+func (ib IfBet) Profit(results ...Result) float64 {
+	if !ib.reverse {
+		order := make([]int, len(ib.legs))
+		for i := range order {
+			order[i] = i
+		}
+		return ib.chainProfit(order, results)
+	}
+	profit := 0.0
+	for _, order := range permutations(len(ib.legs)) {
+		profit += ib.chainProfit(order, results)
+	}
+	return profit
+}
+
+// IfBetOutcome is the profit of an IfBet for one combination of leg results.
+type IfBetOutcome struct {
+	Results []Result
+	Profit  float64
+}
+
+// PayoutMatrix returns the profit of the bet for every combination of win, loss,
+// and push results of its legs.
+func (ib IfBet) PayoutMatrix() []IfBetOutcome {
+	outcomes := []IfBetOutcome{{}}
+	for range ib.legs {
+		var next []IfBetOutcome
+		for _, o := range outcomes {
+			for _, r := range []Result{Win, Loss, Push} {
+				results := append(append([]Result(nil), o.Results...), r)
+				next = append(next, IfBetOutcome{Results: results})
+			}
+		}
+		outcomes = next
+	}
+	for i := range outcomes {
+		outcomes[i].Profit = ib.Profit(outcomes[i].Results...)
+	}
+	return outcomes
+}
+
+// Risk returns the most that can be lost on the bet.
+func (ib IfBet) Risk() float64 {
+	risk := 0.0
+	for _, o := range ib.PayoutMatrix() {
+		if -o.Profit > risk {
+			risk = -o.Profit
+		}
+	}
+	return risk
+}
+
+// ExpectedProfit returns the long term expected profit of the bet given the
+// probability of each leg winning, assuming the legs are independent and do not
+// push.
+func (ib IfBet) ExpectedProfit() float64 {
+	ev := 0.0
+	for _, o := range ib.PayoutMatrix() {
+		prob := 1.0
+		for i, r := range o.Results {
+			switch r {
+			case Win:
+				prob *= ib.legs[i].Prob.decimal
+			case Loss:
+				prob *= 1.0 - ib.legs[i].Prob.decimal
+			default:
+				prob = 0.0
+			}
+		}
+		ev += prob * o.Profit
+	}
+	return ev
+}
@@ -0,0 +1,57 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func ifBetLegs() []Leg {
+	return []Leg{
+		{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.5)},
+		{NewOddsFromDecimal(3.0), NewProbabilityFromDecimal(0.4)},
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	assert.ElementsMatch(t, [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}, permutations(3))
+}
+
+func TestIfBet_Profit(t *testing.T) {
+	ifWin := NewIfWinBet(100.0, ifBetLegs()...)
+	assert.Equal(t, 300.0, ifWin.Profit(Win, Win))
+	assert.Equal(t, 0.0, ifWin.Profit(Win, Loss))
+	assert.Equal(t, -100.0, ifWin.Profit(Loss, Win))
+	assert.Equal(t, 0.0, ifWin.Profit(Push, Win))
+
+	ifAction := NewIfActionBet(100.0, ifBetLegs()...)
+	assert.Equal(t, 200.0, ifAction.Profit(Push, Win))
+	assert.Equal(t, -100.0, ifAction.Profit(Push, Loss))
+
+	reverse := NewWinReverse(100.0, ifBetLegs()...)
+	assert.Equal(t, 600.0, reverse.Profit(Win, Win))
+	assert.Equal(t, -100.0, reverse.Profit(Win, Loss))
+	assert.Equal(t, -200.0, reverse.Profit(Loss, Loss))
+	assert.Equal(t, 200.0, reverse.Profit(Push, Win))
+
+	actionReverse := NewActionReverse(100.0, ifBetLegs()...)
+	assert.Equal(t, 400.0, actionReverse.Profit(Push, Win))
+}
+
+func TestIfBet_PayoutMatrix(t *testing.T) {
+	matrix := NewIfWinBet(100.0, ifBetLegs()...).PayoutMatrix()
+	assert.Len(t, matrix, 9)
+	assert.Equal(t, []Result{Win, Win}, matrix[0].Results)
+	assert.Equal(t, 300.0, matrix[0].Profit)
+}
+
+func TestIfBet_Risk(t *testing.T) {
+	assert.Equal(t, 100.0, NewIfWinBet(100.0, ifBetLegs()...).Risk())
+	assert.Equal(t, 200.0, NewWinReverse(100.0, ifBetLegs()...).Risk())
+}
+
+func TestIfBet_ExpectedProfit(t *testing.T) {
+	// 0.2 * 300 + 0.3 * 0 + 0.5 * -100
+	assert.InDelta(t, 10.0, NewIfWinBet(100.0, ifBetLegs()...).ExpectedProfit(), 1e-9)
+	// Both orderings: 10 above plus 0.2 * 300 + 0.2 * 100 + 0.6 * -100
+	assert.InDelta(t, 30.0, NewWinReverse(100.0, ifBetLegs()...).ExpectedProfit(), 1e-9)
+}
@@ -0,0 +1,189 @@
+package wagering
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// jsonOdds is the JSON representation of Odds. Both formats are held so that a
+// round trip is lossless.
+type jsonOdds struct {
+	Decimal  float64 `json:"decimal"`
+	American float64 `json:"american"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (odds Odds) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonOdds{Decimal: odds.decimalOdds, American: odds.americanOdds})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (odds *Odds) UnmarshalJSON(data []byte) error {
+	var jo jsonOdds
+	if err := json.Unmarshal(data, &jo); err != nil {
+		return err
+	}
+	*odds = Odds{decimalOdds: jo.Decimal, americanOdds: jo.American}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. A Probability is represented by its
+// decimal.
+func (prob Probability) MarshalJSON() ([]byte, error) {
+	return json.Marshal(prob.decimal)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (prob *Probability) UnmarshalJSON(data []byte) error {
+	var decimal float64
+	if err := json.Unmarshal(data, &decimal); err != nil {
+		return err
+	}
+	*prob = NewProbabilityFromDecimal(decimal)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r Result) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *Result) UnmarshalText(text []byte) error {
+	result, err := ParseResult(string(text))
+	if err != nil {
+		return err
+	}
+	*r = result
+	return nil
+}
+
+// jsonBet is the JSON representation of the Bet implementations of this package,
+// discriminated by Type.
+type jsonBet struct {
+	Type       string      `json:"type"`
+	Stake      float64     `json:"stake"`
+	Odds       Odds        `json:"odds"`
+	Legs       []Odds      `json:"legs,omitempty"`
+	TeaserLegs []TeaserLeg `json:"teaserLegs,omitempty"`
+	Selection  string      `json:"selection,omitempty"`
+}
+
+func marshalBet(bet Bet) (jsonBet, error) {
+	jb := jsonBet{Stake: bet.Stake(), Odds: bet.Odds()}
+	switch b := bet.(type) {
+	case Straight:
+		jb.Type = "straight"
+	case Parlay:
+		jb.Type = "parlay"
+		jb.Legs = b.legs
+	case Teaser:
+		jb.Type = "teaser"
+		jb.TeaserLegs = b.legs
+	case Futures:
+		jb.Type = "futures"
+		jb.Selection = b.selection
+	default:
+		return jb, fmt.Errorf("unsupported bet type %T", bet)
+	}
+	return jb, nil
+}
+
+func unmarshalBet(jb jsonBet) (Bet, error) {
+	switch jb.Type {
+	case "straight":
+		return NewStraight(jb.Odds, jb.Stake), nil
+	case "parlay":
+		return NewParlay(jb.Stake, jb.Legs...), nil
+	case "teaser":
+		return NewTeaser(jb.Odds, jb.TeaserLegs...).WithStake(jb.Stake), nil
+	case "futures":
+		return NewFutures(jb.Selection, jb.Odds, jb.Stake), nil
+	}
+	return nil, fmt.Errorf("unknown bet type %q", jb.Type)
+}
+
+// jsonEntry is the JSON representation of a LedgerEntry.
+type jsonEntry struct {
+	Time      time.Time `json:"time"`
+	Event     string    `json:"event,omitempty"`
+	Market    string    `json:"market,omitempty"`
+	Book      string    `json:"book,omitempty"`
+	Selection string    `json:"selection,omitempty"`
+	Bet       jsonBet   `json:"bet"`
+	Pending   bool      `json:"pending,omitempty"`
+	Result    Result    `json:"result"`
+	Payout    float64   `json:"payout"`
+	Closing   Odds      `json:"closing"`
+}
+
+// MarshalJSON implements json.Marshaler. Only bets of the types in this package
+// can be marshaled.
+func (l Ledger) MarshalJSON() ([]byte, error) {
+	entries := []jsonEntry{}
+	for _, e := range l.entries {
+		jb, err := marshalBet(e.Bet)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, jsonEntry{
+			Time:      e.Time,
+			Event:     e.Event,
+			Market:    e.Market,
+			Book:      e.Book,
+			Selection: e.Selection,
+			Bet:       jb,
+			Pending:   e.Pending,
+			Result:    e.Result,
+			Payout:    e.Payout,
+			Closing:   e.Closing,
+		})
+	}
+	return json.Marshal(struct {
+		Entries []jsonEntry `json:"entries"`
+	}{entries})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the entries of the ledger.
+func (l *Ledger) UnmarshalJSON(data []byte) error {
+	var jl struct {
+		Entries []jsonEntry `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &jl); err != nil {
+		return err
+	}
+	var entries []LedgerEntry
+	for _, je := range jl.Entries {
+		bet, err := unmarshalBet(je.Bet)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, LedgerEntry{
+			Time:      je.Time,
+			Event:     je.Event,
+			Market:    je.Market,
+			Book:      je.Book,
+			Selection: je.Selection,
+			Bet:       bet,
+			Pending:   je.Pending,
+			Result:    je.Result,
+			Payout:    je.Payout,
+			Closing:   je.Closing,
+		})
+	}
+	l.entries = entries
+	return nil
+}
+
+// Save writes a JSON snapshot of the ledger to w.
+func (l *Ledger) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(l)
+}
+
+// Load replaces the entries of the ledger with those of the JSON snapshot read
+// from r.
+func (l *Ledger) Load(r io.Reader) error {
+	return json.NewDecoder(r).Decode(l)
+}
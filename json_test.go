@@ -0,0 +1,59 @@
+package wagering
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOdds_JSON(t *testing.T) {
+	odds := NewOddsFromAmerican(-110.0)
+	data, err := json.Marshal(odds)
+	assert.NoError(t, err)
+	var decoded Odds
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, odds, decoded)
+}
+
+func TestProbability_JSON(t *testing.T) {
+	data, err := json.Marshal(NewProbabilityFromPercent(25.0))
+	assert.NoError(t, err)
+	assert.Equal(t, "0.25", string(data))
+	var decoded Probability
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, NewProbabilityFromPercent(25.0), decoded)
+}
+
+func TestLedger_SaveLoad(t *testing.T) {
+	l := pendingLedger()
+	l.Record(standardTeaser(TeaserLeg{Line: -7.5, Points: 6.0}, TeaserLeg{Line: 1.5, Points: 6.0}).WithStake(60.0), Odds{}, Win, Loss)
+	l.Record(NewFutures("Chiefs", NewOddsFromAmerican(+600.0), 10.0), NewOddsFromAmerican(+450.0), Loss)
+	l.Add(LedgerEntry{
+		Time:   time.Date(2023, 9, 10, 13, 0, 0, 0, time.UTC),
+		Market: "Chiefs -3.5",
+		Bet:    NewStraight(NewOddsFromAmerican(-110.0), 110.0),
+		Result: HalfWin,
+		Payout: 160.0,
+	})
+
+	var buf bytes.Buffer
+	assert.NoError(t, l.Save(&buf))
+	assert.Contains(t, buf.String(), `"result":"half win"`)
+
+	loaded := NewLedger()
+	loaded.Record(NewStraight(NewOddsFromDecimal(2.0), 1.0), Odds{}, Win)
+	assert.NoError(t, loaded.Load(&buf))
+	assert.Equal(t, l.Entries(), loaded.Entries())
+	assert.Equal(t, l.Profit(), loaded.Profit())
+}
+
+func TestLedger_LoadError(t *testing.T) {
+	l := NewLedger()
+	err := l.Load(strings.NewReader(`{"entries":[{"bet":{"type":"exotic"}}]}`))
+	assert.EqualError(t, err, `unknown bet type "exotic"`)
+	err = l.Load(strings.NewReader(`{"entries":[{"bet":{"type":"straight"},"result":"maybe"}]}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,318 @@
+package wagering
+
+import (
+	"math"
+	"sort"
+)
+
+// outcome is one joint outcome of a set of bets, each bet's return per unit staked
+// and the probability of the outcome.
+type outcome struct {
+	returns []float64
+	prob    float64
+}
+
+// independentOutcomes returns every joint outcome of the independent bets.
+func independentOutcomes(bets []Leg) []outcome {
+	outcomes := []outcome{{prob: 1.0}}
+	for _, b := range bets {
+		var next []outcome
+		for _, o := range outcomes {
+			win := append(append([]float64(nil), o.returns...), b.Odds.decimalOdds-1.0)
+			loss := append(append([]float64(nil), o.returns...), -1.0)
+			next = append(next,
+				outcome{win, o.prob * b.Prob.decimal},
+				outcome{loss, o.prob * (1.0 - b.Prob.decimal)})
+		}
+		outcomes = next
+	}
+	return outcomes
+}
+
+// wealth returns the wealth, per unit of starting bankroll, of staking fractions
+// in the outcome.
+func (o outcome) wealth(fractions []float64) float64 {
+	w := 1.0
+	for i, f := range fractions {
+		w += f * o.returns[i]
+	}
+	return w
+}
+
+// expectedLogWealth returns the expected log of the wealth of staking fractions
+// across the outcomes.
+func expectedLogWealth(outcomes []outcome, fractions []float64) float64 {
+	g := 0.0
+	for _, o := range outcomes {
+		if o.prob > 0.0 {
+			g += o.prob * math.Log(o.wealth(fractions))
+		}
+	}
+	return g
+}
+
+// maximizeGrowth returns the non-negative fractions, each no more than the
+// corresponding upper bound when upper is not nil, maximizing the expected log
+// wealth across the outcomes, and that expected log wealth, by coordinate wise
+// Newton steps. Steps are shortened to keep the wealth of every outcome positive.
+func maximizeGrowth(outcomes []outcome, n int, upper []float64) ([]float64, float64) {
+	fractions := make([]float64, n)
+	for iteration := 0; iteration < 1000; iteration++ {
+		change := 0.0
+		for i := 0; i < n; i++ {
+			gradient := 0.0
+			hessian := 0.0
+			for _, o := range outcomes {
+				w := o.wealth(fractions)
+				gradient += o.prob * o.returns[i] / w
+				hessian -= o.prob * o.returns[i] * o.returns[i] / (w * w)
+			}
+			if hessian == 0.0 {
+				continue
+			}
+			current := fractions[i]
+			step := -gradient / hessian
+			for {
+				fractions[i] = math.Max(current+step, 0.0)
+				if upper != nil {
+					fractions[i] = math.Min(fractions[i], upper[i])
+				}
+				if feasible(outcomes, fractions) {
+					break
+				}
+				step /= 2.0
+			}
+			change = math.Max(change, math.Abs(fractions[i]-current))
+		}
+		if change < 1e-12 {
+			break
+		}
+	}
+	return fractions, expectedLogWealth(outcomes, fractions)
+}
+
+// feasible returns whether the wealth of staking fractions is positive in every
+// outcome with a non-zero probability.
+func feasible(outcomes []outcome, fractions []float64) bool {
+	for _, o := range outcomes {
+		if o.prob > 0.0 && o.wealth(fractions) <= 0.0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SimultaneousKelly returns the fractions of the bankroll to wager on each of the
+// concurrent, independent, bets that jointly maximize the expected log growth of the
+// bankroll, and that growth rate per round. Unlike applying KellyFraction to each
+// bet it accounts for the bets being settled together. The number of joint
+// outcomes, and so the work, doubles with each bet.
+// https://en.wikipedia.org/wiki/Kelly_criterion
+func SimultaneousKelly(bets []Leg) (fractions []float64, growth float64) {
+	return maximizeGrowth(independentOutcomes(bets), len(bets), nil)
+}
+
+// ExclusiveKelly returns the fractions of the bankroll to wager on each of the bets
+// on mutually exclusive outcomes of the same event, such as several horses in a
+// race, that maximize the expected log growth of the bankroll, and that growth
+// rate. Any probability not covered by the bets is that of none of them winning.
+// The fractions are found with the algorithm of Smoczynski and Tomkins, adding
+// bets in order of expected return while it exceeds the reserve rate of the bets
+// already added.
+// https://en.wikipedia.org/wiki/Kelly_criterion#Multiple_outcomes
+func ExclusiveKelly(bets []Leg) (fractions []float64, growth float64) {
+	order := make([]int, len(bets))
+	for i := range order {
+		order[i] = i
+	}
+	expectedReturn := func(i int) float64 {
+		return bets[i].Prob.decimal * bets[i].Odds.decimalOdds
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return expectedReturn(order[a]) > expectedReturn(order[b])
+	})
+
+	reserve := 1.0
+	probSum := 0.0
+	impliedSum := 0.0
+	var chosen []int
+	for _, i := range order {
+		if expectedReturn(i) <= reserve || impliedSum+bets[i].Odds.ImpliedProb().decimal >= 1.0 {
+			break
+		}
+		chosen = append(chosen, i)
+		probSum += bets[i].Prob.decimal
+		impliedSum += bets[i].Odds.ImpliedProb().decimal
+		reserve = (1.0 - probSum) / (1.0 - impliedSum)
+	}
+
+	fractions = make([]float64, len(bets))
+	for _, i := range chosen {
+		fractions[i] = bets[i].Prob.decimal - reserve/bets[i].Odds.decimalOdds
+	}
+	return fractions, expectedLogWealth(exclusiveOutcomes(bets), fractions)
+}
+
+// exclusiveOutcomes returns the outcomes of bets on mutually exclusive outcomes of
+// the same event, where exactly one of the bets, or none of them, wins.
+func exclusiveOutcomes(bets []Leg) []outcome {
+	var outcomes []outcome
+	none := 1.0
+	for i, b := range bets {
+		returns := make([]float64, len(bets))
+		for j := range returns {
+			returns[j] = -1.0
+		}
+		returns[i] = b.Odds.decimalOdds - 1.0
+		outcomes = append(outcomes, outcome{returns, b.Prob.decimal})
+		none -= b.Prob.decimal
+	}
+	losses := make([]float64, len(bets))
+	for j := range losses {
+		losses[j] = -1.0
+	}
+	return append(outcomes, outcome{losses, math.Max(none, 0.0)})
+}
+
+// UncertainKellyFraction returns the fraction of the bankroll to wager at odds when
+// the probability of success is an estimate with the given standard error, and the
+// kelly multiplier. The Kelly fraction of the estimate is shrunk by the factor
+// f^2 / (f^2 + Var(f)) of Baker and McHale, where Var(f) is the variance the
+// estimation error induces in the Kelly fraction, so noisier estimates are staked
+// less.
+// https://doi.org/10.1287/deca.2013.0271
+func (odds Odds) UncertainKellyFraction(prob Probability, stdErr, mult float64) float64 {
+	f := odds.KellyFraction(prob, 1.0)
+	if f == 0.0 {
+		return 0.0
+	}
+	// The Kelly fraction is linear in p with slope d / (d - 1).
+	slope := odds.decimalOdds / (odds.decimalOdds - 1.0)
+	variance := slope * slope * stdErr * stdErr
+	return mult * f * f * f / (f*f + variance)
+}
+
+// BetaKellyFraction returns the fraction of the bankroll to wager at odds when the
+// probability of success has a Beta(alpha, beta) distribution, such as the
+// posterior after alpha - 1 wins and beta - 1 losses from a uniform prior, and the
+// kelly multiplier. See UncertainKellyFraction.
+func (odds Odds) BetaKellyFraction(alpha, beta, mult float64) float64 {
+	n := alpha + beta
+	prob := NewProbabilityFromDecimal(alpha / n)
+	stdErr := math.Sqrt(alpha * beta / (n * n * (n + 1.0)))
+	return odds.UncertainKellyFraction(prob, stdErr, mult)
+}
+
+// CappedSimultaneousKelly returns the stakes for the concurrent, independent, bets
+// that maximize the expected log growth of the bankroll, as in SimultaneousKelly,
+// subject to each stake being no more than maxStakes and either zero or at least
+// minStakes, such as book limits and minimum bet sizes. When a cap binds the other
+// stakes are re-optimized rather than simply clipped, and bets whose optimal stake
+// falls below their minimum are dropped. Either of maxStakes or minStakes may be nil
+// for no constraint. The growth rate of the stakes and its cost, the reduction from
+// the unconstrained growth rate, are also returned.
+func CappedSimultaneousKelly(bets []Leg, bankroll float64, maxStakes, minStakes []float64) (stakes []float64, growth, cost float64) {
+	outcomes := independentOutcomes(bets)
+	_, unconstrained := maximizeGrowth(outcomes, len(bets), nil)
+	upper := make([]float64, len(bets))
+	for i := range upper {
+		upper[i] = math.Inf(1)
+		if maxStakes != nil {
+			upper[i] = maxStakes[i] / bankroll
+		}
+	}
+	var fractions []float64
+	for {
+		fractions, growth = maximizeGrowth(outcomes, len(bets), upper)
+		dropped := false
+		for i, f := range fractions {
+			if minStakes != nil && f > 0.0 && f*bankroll < minStakes[i] {
+				upper[i] = 0.0
+				dropped = true
+			}
+		}
+		if !dropped {
+			break
+		}
+	}
+	for _, f := range fractions {
+		stakes = append(stakes, f*bankroll)
+	}
+	return stakes, growth, unconstrained - growth
+}
+
+// GrowthRate returns the expected log of the wealth, per unit of bankroll, after
+// staking fraction of the bankroll at odds with the given probability of winning.
+// It is maximized by the Kelly fraction and is negative when over betting at more
+// than about twice the Kelly fraction.
+func GrowthRate(odds Odds, prob Probability, fraction float64) float64 {
+	p := prob.decimal
+	return p*math.Log(1.0+fraction*(odds.decimalOdds-1.0)) + (1.0-p)*math.Log(1.0-fraction)
+}
+
+// ReturnVariance returns the variance of the change in wealth, per unit of bankroll,
+// of staking fraction of the bankroll at odds with the given probability of
+// winning.
+func ReturnVariance(odds Odds, prob Probability, fraction float64) float64 {
+	p := prob.decimal
+	return fraction * fraction * p * (1.0 - p) * odds.decimalOdds * odds.decimalOdds
+}
+
+// GrowthVolatility returns the standard deviation of the log of the wealth, per
+// unit of bankroll, after staking fraction of the bankroll at odds with the given
+// probability of winning. Over n bets the volatility grows with the square root of
+// n while GrowthRate grows with n.
+func GrowthVolatility(odds Odds, prob Probability, fraction float64) float64 {
+	p := prob.decimal
+	win := math.Log(1.0 + fraction*(odds.decimalOdds-1.0))
+	loss := math.Log(1.0 - fraction)
+	mu := GrowthRate(odds, prob, fraction)
+	return math.Sqrt(p*win*win + (1.0-p)*loss*loss - mu*mu)
+}
+
+// VolatilityTargetFraction returns the fraction of the bankroll to wager at odds,
+// with the given probability of winning, so that the GrowthVolatility per bet is the
+// target, as an alternative to an arbitrary kelly multiplier. The result never
+// exceeds the full Kelly fraction.
+func VolatilityTargetFraction(odds Odds, prob Probability, target float64) float64 {
+	kelly := odds.KellyFraction(prob, 1.0)
+	if GrowthVolatility(odds, prob, kelly) <= target {
+		return kelly
+	}
+	low, high := 0.0, kelly
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2.0
+		if GrowthVolatility(odds, prob, mid) < target {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2.0
+}
+
+// CRRAFraction returns the fraction of the bankroll to wager at odds, with the
+// given probability of winning, that maximizes the expected constant relative risk
+// aversion utility of the resulting wealth. A riskAversion of one is log utility,
+// for which the result is the full Kelly fraction, while larger values are more
+// risk averse, roughly wagering the Kelly fraction divided by riskAversion when the
+// edge is small.
+// https://en.wikipedia.org/wiki/Isoelastic_utility
+func CRRAFraction(odds Odds, prob Probability, riskAversion float64) float64 {
+	p := prob.decimal
+	profitMult := odds.decimalOdds - 1.0
+	if p*profitMult <= 1.0-p {
+		return 0.0
+	}
+	if p >= 1.0 {
+		return 1.0
+	}
+	r := math.Pow(p*profitMult/(1.0-p), 1.0/riskAversion)
+	return (r - 1.0) / (profitMult + r)
+}
+
+// CRRAStake returns the amount of the bankroll to wager at odds, with the given
+// probability of winning, as determined by CRRAFraction.
+func CRRAStake(odds Odds, prob Probability, riskAversion, bankroll float64) float64 {
+	return bankroll * CRRAFraction(odds, prob, riskAversion)
+}
@@ -0,0 +1,142 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestSimultaneousKelly(t *testing.T) {
+	// A single bet matches KellyFraction.
+	bet := Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.6)}
+	fractions, growth := SimultaneousKelly([]Leg{bet})
+	assert.InDelta(t, bet.Odds.KellyFraction(bet.Prob, 1.0), fractions[0], 1e-9)
+	assert.InDelta(t, 0.6*math.Log(1.2)+0.4*math.Log(0.8), growth, 1e-9)
+
+	// Two simultaneous even money bets at 60% are each staked less than alone.
+	fractions, growth = SimultaneousKelly([]Leg{bet, bet})
+	assert.InDelta(t, 0.1923, fractions[0], 0.0001)
+	assert.InDelta(t, fractions[0], fractions[1], 1e-9)
+	assert.Greater(t, growth, 0.6*math.Log(1.2)+0.4*math.Log(0.8))
+
+	// Negative expectation bets are not staked.
+	bad := Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.4)}
+	fractions, _ = SimultaneousKelly([]Leg{bet, bad})
+	assert.InDelta(t, 0.2, fractions[0], 1e-9)
+	assert.Equal(t, 0.0, fractions[1])
+}
+
+func TestExclusiveKelly(t *testing.T) {
+	// A single bet matches KellyFraction.
+	bet := Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.6)}
+	fractions, _ := ExclusiveKelly([]Leg{bet})
+	assert.InDelta(t, 0.2, fractions[0], 1e-9)
+
+	// Two horses each at 4.0 with 30% and 20%, one positive and one not.
+	fractions, growth := ExclusiveKelly([]Leg{
+		{NewOddsFromDecimal(4.0), NewProbabilityFromDecimal(0.3)},
+		{NewOddsFromDecimal(4.0), NewProbabilityFromDecimal(0.2)},
+	})
+	assert.InDelta(t, 0.0667, fractions[0], 0.0001)
+	assert.Equal(t, 0.0, fractions[1])
+	assert.Greater(t, growth, 0.0)
+
+	// An arbitrage across both outcomes of a two way market stakes the bankroll.
+	fractions, _ = ExclusiveKelly([]Leg{
+		{NewOddsFromDecimal(2.2), NewProbabilityFromDecimal(0.5)},
+		{NewOddsFromDecimal(2.2), NewProbabilityFromDecimal(0.5)},
+	})
+	assert.InDelta(t, 1.0, fractions[0]+fractions[1], 1e-9)
+	assert.InDelta(t, fractions[0], fractions[1], 1e-9)
+}
+
+func TestOdds_UncertainKellyFraction(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.55)
+	assert.InDelta(t, odds.KellyFraction(prob, 1.0), odds.UncertainKellyFraction(prob, 0.0, 1.0), 1e-9)
+	// f = 0.1 and Var(f) = (2 * 0.05)^2 = 0.01 halves the stake.
+	assert.InDelta(t, 0.05, odds.UncertainKellyFraction(prob, 0.05, 1.0), 1e-9)
+	assert.InDelta(t, 0.025, odds.UncertainKellyFraction(prob, 0.05, 0.5), 1e-9)
+	assert.Equal(t, 0.0, odds.UncertainKellyFraction(NewProbabilityFromDecimal(0.45), 0.05, 1.0))
+}
+
+func TestOdds_BetaKellyFraction(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	// More evidence for the same win rate stakes more.
+	small := odds.BetaKellyFraction(11.0, 9.0, 1.0)
+	large := odds.BetaKellyFraction(1100.0, 900.0, 1.0)
+	assert.Less(t, small, large)
+	assert.InDelta(t, 0.0953, large, 0.0001)
+	assert.InDelta(t, 0.0175, small, 0.0001)
+}
+
+func TestCappedSimultaneousKelly(t *testing.T) {
+	bet := Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.6)}
+	weak := Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.52)}
+	bets := []Leg{bet, bet, weak}
+	fractions, unconstrained := SimultaneousKelly(bets)
+
+	stakes, growth, cost := CappedSimultaneousKelly(bets, 1000.0, nil, nil)
+	for i, f := range fractions {
+		assert.InDelta(t, f*1000.0, stakes[i], 1e-6)
+	}
+	assert.InDelta(t, unconstrained, growth, 1e-12)
+	assert.InDelta(t, 0.0, cost, 1e-12)
+
+	// Capping the first bet shifts stake to the others.
+	stakes, _, cost = CappedSimultaneousKelly(bets, 1000.0, []float64{50.0, 1000.0, 1000.0}, nil)
+	assert.InDelta(t, 50.0, stakes[0], 1e-9)
+	assert.Greater(t, stakes[1], fractions[1]*1000.0)
+	assert.Greater(t, cost, 0.0)
+
+	// The weak bet is too small to meet its minimum and is dropped.
+	stakes, _, cost = CappedSimultaneousKelly(bets, 1000.0, nil, []float64{10.0, 10.0, 50.0})
+	assert.Equal(t, 0.0, stakes[2])
+	assert.Greater(t, stakes[0], fractions[0]*1000.0)
+	assert.Greater(t, cost, 0.0)
+}
+
+func TestGrowthRate(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	kelly := odds.KellyFraction(prob, 1.0)
+	assert.InDelta(t, 0.0201, GrowthRate(odds, prob, kelly), 0.0001)
+	assert.Equal(t, 0.0, GrowthRate(odds, prob, 0.0))
+	assert.Less(t, GrowthRate(odds, prob, kelly/2.0), GrowthRate(odds, prob, kelly))
+	assert.Less(t, GrowthRate(odds, prob, kelly*1.5), GrowthRate(odds, prob, kelly))
+	assert.Less(t, GrowthRate(odds, prob, kelly*2.5), 0.0)
+	// Half Kelly keeps three quarters of the growth.
+	assert.InDelta(t, 0.75, GrowthRate(odds, prob, kelly/2.0)/GrowthRate(odds, prob, kelly), 0.02)
+}
+
+func TestReturnVariance(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	// Returns of +0.2 and -0.2 with probabilities 0.6 and 0.4.
+	assert.InDelta(t, 0.0384, ReturnVariance(odds, prob, 0.2), 1e-9)
+}
+
+func TestGrowthVolatility(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	assert.InDelta(t, 0.1986, GrowthVolatility(odds, prob, 0.2), 0.0001)
+	assert.Equal(t, 0.0, GrowthVolatility(odds, prob, 0.0))
+}
+
+func TestVolatilityTargetFraction(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	fraction := VolatilityTargetFraction(odds, prob, 0.1)
+	assert.InDelta(t, 0.1, GrowthVolatility(odds, prob, fraction), 1e-9)
+	assert.InDelta(t, 0.102, fraction, 0.001)
+	assert.InDelta(t, 0.2, VolatilityTargetFraction(odds, prob, 0.5), 1e-9)
+}
+
+func TestCRRAFraction(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	assert.InDelta(t, odds.KellyFraction(prob, 1.0), CRRAFraction(odds, prob, 1.0), 1e-9)
+	assert.InDelta(t, 0.1010, CRRAFraction(odds, prob, 2.0), 0.0001)
+	assert.Equal(t, 0.0, CRRAFraction(odds, NewProbabilityFromDecimal(0.4), 2.0))
+	assert.InDelta(t, 101.0, CRRAStake(odds, prob, 2.0, 1000.0), 0.1)
+}
@@ -0,0 +1,86 @@
+package wagering
+
+import (
+	"math"
+)
+
+// KeyNumbers maps an absolute final margin of victory to the probability of a game
+// ending with that margin, by either team, such as the frequency of three point NFL
+// games. Margins not present are taken to have probability zero. Tables can be
+// replaced with ones fitted to any sport or period.
+type KeyNumbers map[int]float64
+
+// NFLKeyNumbers holds approximate frequencies of NFL final margins.
+var NFLKeyNumbers = KeyNumbers{
+	0: 0.003, 1: 0.035, 2: 0.040, 3: 0.150, 4: 0.050, 5: 0.040, 6: 0.060, 7: 0.090,
+	8: 0.035, 9: 0.020, 10: 0.055, 11: 0.020, 12: 0.015, 13: 0.020, 14: 0.045,
+	15: 0.015, 16: 0.020, 17: 0.035, 18: 0.015, 19: 0.010, 20: 0.015, 21: 0.025,
+}
+
+// CFBKeyNumbers holds approximate frequencies of college football final margins.
+var CFBKeyNumbers = KeyNumbers{
+	1: 0.025, 2: 0.020, 3: 0.080, 4: 0.035, 5: 0.025, 6: 0.035, 7: 0.065, 8: 0.025,
+	9: 0.015, 10: 0.045, 11: 0.020, 12: 0.015, 13: 0.015, 14: 0.040, 17: 0.030,
+	21: 0.025, 24: 0.020, 28: 0.020,
+}
+
+// NBAKeyNumbers holds approximate frequencies of NBA final margins, which lack the
+// pronounced key numbers of football.
+var NBAKeyNumbers = KeyNumbers{
+	1: 0.030, 2: 0.035, 3: 0.040, 4: 0.035, 5: 0.040, 6: 0.040, 7: 0.040, 8: 0.035,
+	9: 0.035, 10: 0.035, 11: 0.030, 12: 0.030, 13: 0.025, 14: 0.025, 15: 0.025,
+}
+
+// isInteger returns whether the line falls on a whole number.
+func isInteger(line float64) bool {
+	return line == math.Trunc(line)
+}
+
+// side returns the probability of a game ending with a given team winning by the
+// absolute margin of line, half that of either team doing so, or of a tie.
+func (kn KeyNumbers) side(line float64) float64 {
+	margin := int(math.Abs(line))
+	if margin == 0 {
+		return kn[0]
+	}
+	return kn[margin] / 2.0
+}
+
+// HalfPointValue returns the value of moving the point spread line, such as -3 for
+// a three point favorite or +3 for an underdog, by half a point in the bettor's
+// favor when buy is true, or against when false, at the given odds. It returns the
+// odds at the new line with the same expected value and the cents, on the
+// continuous american scale, between those odds and the given odds. Buying the
+// half point is worthwhile when it costs fewer cents than returned.
+//
+// The original line is assumed fair at the given odds, with wins and losses
+// equally likely, and the probability of each push taken from kn, as half the
+// frequency of its margin since only a win by one of the teams lands on the line.
+func (kn KeyNumbers) HalfPointValue(line float64, buy bool, odds Odds) (Odds, float64) {
+	push := 0.0
+	if isInteger(line) {
+		push = kn.side(line)
+	}
+	win := (1.0 - push) / 2.0
+	loss := win
+	newWin, newLoss, newPush := win, loss, 0.0
+	switch {
+	case buy && isInteger(line):
+		// Pushes become wins.
+		newWin += push
+	case buy:
+		// Losses by the key number become pushes.
+		newPush = kn.side(line + 0.5)
+		newLoss -= newPush
+	case isInteger(line):
+		// Pushes become losses.
+		newLoss += push
+	default:
+		// Wins by the key number become pushes.
+		newPush = kn.side(line - 0.5)
+		newWin -= newPush
+	}
+	ev := win*(odds.decimalOdds-1.0) - loss
+	newOdds := NewOddsFromDecimal(1.0 + (ev+newLoss)/newWin)
+	return newOdds, americanScale(odds) - americanScale(newOdds)
+}
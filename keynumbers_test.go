@@ -0,0 +1,38 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeyNumbers_HalfPointValue(t *testing.T) {
+	odds := NewOddsFromAmerican(-110.0)
+
+	// Buying off of the 3 turns the 7.5% of pushes, the favorite winning by 3 in
+	// half the 15% of games decided by 3, into wins.
+	newOdds, cents := NFLKeyNumbers.HalfPointValue(-3.0, true, odds)
+	assert.InDelta(t, -128.0, newOdds.American(), 1.0)
+	assert.InDelta(t, 18.0, cents, 1.0)
+
+	// Buying onto the 3 turns losses by 3 into pushes.
+	_, onto := NFLKeyNumbers.HalfPointValue(+2.5, true, odds)
+	assert.InDelta(t, 22.0, onto, 1.0)
+
+	// Buying onto a pick'em turns every tie into a push.
+	_, pickem := NFLKeyNumbers.HalfPointValue(-0.5, true, odds)
+	assert.InDelta(t, 0.7, pickem, 0.1)
+
+	// Half points onto dead numbers are worth little.
+	_, dead := NFLKeyNumbers.HalfPointValue(-12.5, true, odds)
+	assert.Greater(t, dead, 0.0)
+	assert.Less(t, dead, 5.0)
+
+	// Selling a half point costs cents.
+	_, sold := NFLKeyNumbers.HalfPointValue(-7.0, false, odds)
+	assert.Less(t, sold, 0.0)
+
+	custom := KeyNumbers{3: 0.0}
+	newOdds, cents = custom.HalfPointValue(-3.0, true, odds)
+	assert.InDelta(t, odds.Decimal(), newOdds.Decimal(), 1e-12)
+	assert.InDelta(t, 0.0, cents, 1e-9)
+}
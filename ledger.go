@@ -0,0 +1,419 @@
+package wagering
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+	"time"
+)
+
+// LedgerEntry is a bet recorded in a Ledger.
+type LedgerEntry struct {
+	// Time is when the bet was placed, or the zero Time if unknown.
+	Time time.Time
+	// Event describes the event the bet was placed on.
+	Event string
+	// Market describes the market the bet was placed in.
+	Market string
+	// Book is the book the bet was placed with.
+	Book string
+	// Selection describes the outcome the bet backs, such as "KC -3.5", so that
+	// pending bets on different outcomes of a market offset in their Exposure. See
+	// Ledger.SetMarketOutcomes.
+	Selection string
+	Bet       Bet
+	// Pending is whether the bet is still open, in which case Result and Payout
+	// are not yet meaningful.
+	Pending bool
+	Result  Result
+	// Payout is the total return of the bet, including stake.
+	Payout float64
+	// Closing is the closing odds of the bet's selection, or the zero Odds if
+	// unknown.
+	Closing Odds
+}
+
+// Profit returns the net profit of the entry.
+func (e LedgerEntry) Profit() float64 {
+	return e.Payout - e.Bet.Stake()
+}
+
+// hasClosing returns whether the closing odds of the entry are known.
+func (e LedgerEntry) hasClosing() bool {
+	return e.Closing.decimalOdds != 0.0
+}
+
+// Ledger accumulates bets and reports on the performance of those settled and the
+// exposure of those pending.
+type Ledger struct {
+	entries []LedgerEntry
+	// outcomes holds the complete outcomes of markets set by SetMarketOutcomes.
+	outcomes map[eventMarket][]string
+}
+
+// eventMarket identifies a market of an event.
+type eventMarket struct {
+	event, market string
+}
+
+// SetMarketOutcomes records every outcome of the market of event, exactly one of
+// which wins, such as "home", "draw" and "away", so that pending bets on them, by
+// Selection, offset in their Exposure. Bets on markets without their outcomes set
+// are taken to be independent.
+func (l *Ledger) SetMarketOutcomes(event, market string, outcomes ...string) {
+	if l.outcomes == nil {
+		l.outcomes = map[eventMarket][]string{}
+	}
+	l.outcomes[eventMarket{event, market}] = outcomes
+}
+
+// marketOutcomes returns the outcomes of the market of the entry, as set by
+// SetMarketOutcomes, if the entry backs one of them, or nil otherwise. Entries
+// without an event or market are never netted.
+func (l *Ledger) marketOutcomes(e LedgerEntry) []string {
+	if e.Event == "" || e.Market == "" {
+		return nil
+	}
+	outcomes := l.outcomes[eventMarket{e.Event, e.Market}]
+	if !slices.Contains(outcomes, e.selection()) {
+		return nil
+	}
+	return outcomes
+}
+
+// NewLedger constructs a new, empty, Ledger.
+func NewLedger() Ledger {
+	return Ledger{}
+}
+
+// Record settles bet with the given results and records it in the ledger along with
+// the closing odds of its selection, which may be the zero Odds if unknown. Nothing
+// is recorded if the bet cannot be settled with the results.
+func (l *Ledger) Record(bet Bet, closing Odds, results ...Result) (LedgerEntry, error) {
+	result, payout, err := bet.Settle(results...)
+	if err != nil {
+		return LedgerEntry{}, err
+	}
+	entry := LedgerEntry{Bet: bet, Result: result, Payout: payout, Closing: closing}
+	l.entries = append(l.entries, entry)
+	return entry, nil
+}
+
+// Add records an entry, settled or pending, in the ledger.
+func (l *Ledger) Add(entry LedgerEntry) {
+	l.entries = append(l.entries, entry)
+}
+
+// Settle settles the pending entry at index i with the given results and closing
+// odds, which may be the zero Odds if unknown. An error is returned if there is no
+// pending entry at i, and the entry is left pending if its bet cannot be settled
+// with the results.
+func (l *Ledger) Settle(i int, closing Odds, results ...Result) (LedgerEntry, error) {
+	if i < 0 || i >= len(l.entries) {
+		return LedgerEntry{}, fmt.Errorf("no entry %d in a ledger of %d", i, len(l.entries))
+	}
+	e := &l.entries[i]
+	if !e.Pending {
+		return *e, fmt.Errorf("entry %d is already settled", i)
+	}
+	result, payout, err := e.Bet.Settle(results...)
+	if err != nil {
+		return *e, err
+	}
+	e.Result, e.Payout = result, payout
+	e.Closing = closing
+	e.Pending = false
+	return *e, nil
+}
+
+// settled returns the entries of the ledger that have been settled.
+func (l *Ledger) settled() []LedgerEntry {
+	var settled []LedgerEntry
+	for _, e := range l.entries {
+		if !e.Pending {
+			settled = append(settled, e)
+		}
+	}
+	return settled
+}
+
+// pending returns the entries of the ledger that are pending.
+func (l *Ledger) pending() []LedgerEntry {
+	var pending []LedgerEntry
+	for _, e := range l.entries {
+		if e.Pending {
+			pending = append(pending, e)
+		}
+	}
+	return pending
+}
+
+// Entries returns the entries of the ledger in the order they were recorded.
+func (l *Ledger) Entries() []LedgerEntry {
+	return l.entries
+}
+
+// All returns an iterator over the index and entry of each entry of the ledger in
+// the order they were recorded.
+func (l *Ledger) All() iter.Seq2[int, LedgerEntry] {
+	return func(yield func(int, LedgerEntry) bool) {
+		for i, e := range l.entries {
+			if !yield(i, e) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the odds of the bet of each entry of the ledger
+// in the order they were recorded.
+func (l *Ledger) Values() iter.Seq[Odds] {
+	return func(yield func(Odds) bool) {
+		for _, e := range l.entries {
+			if !yield(e.Bet.Odds()) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of entries in the ledger.
+func (l *Ledger) Len() int {
+	return len(l.entries)
+}
+
+// Staked returns the total amount wagered on settled bets.
+func (l *Ledger) Staked() float64 {
+	staked := 0.0
+	for _, e := range l.settled() {
+		staked += e.Bet.Stake()
+	}
+	return staked
+}
+
+// Profit returns the total net profit of settled bets.
+func (l *Ledger) Profit() float64 {
+	profit := 0.0
+	for _, e := range l.settled() {
+		profit += e.Profit()
+	}
+	return profit
+}
+
+// UnitsWon returns the total net profit in units of the given size.
+func (l *Ledger) UnitsWon(unit float64) float64 {
+	return l.Profit() / unit
+}
+
+// ROI returns the return on investment, the total net profit as a decimal of the
+// total amount wagered, or 0 if nothing has been wagered.
+func (l *Ledger) ROI() float64 {
+	staked := l.Staked()
+	if staked == 0.0 {
+		return 0.0
+	}
+	return l.Profit() / staked
+}
+
+// Yield returns the average across settled bets of the net profit of each bet as a
+// decimal of its stake, or 0 if there are none. Unlike ROI each bet is weighted
+// equally regardless of its stake.
+func (l *Ledger) Yield() float64 {
+	settled := l.settled()
+	if len(settled) == 0 {
+		return 0.0
+	}
+	sum := 0.0
+	for _, e := range settled {
+		sum += e.Profit() / e.Bet.Stake()
+	}
+	return sum / float64(len(settled))
+}
+
+// AverageOdds returns the average odds of the settled bets.
+func (l *Ledger) AverageOdds() Odds {
+	ao := NewAverageOdds()
+	for _, e := range l.settled() {
+		ao.Accumulate(e.Bet.Odds())
+	}
+	return ao.Average()
+}
+
+// clv returns the closing line value of the entry as the expected value of its odds
+// at its closing odds.
+func (e LedgerEntry) clv() float64 {
+	_, ev := CLV(e.Bet.Odds(), e.Closing)
+	return ev
+}
+
+// CLV returns the average closing line value, the expected value of each bet at its
+// closing odds, across the settled bets with known closing odds, or 0 if there are
+// none.
+func (l *Ledger) CLV() float64 {
+	sum := 0.0
+	count := 0
+	for _, e := range l.settled() {
+		if e.hasClosing() {
+			sum += e.clv()
+			count++
+		}
+	}
+	if count == 0 {
+		return 0.0
+	}
+	return sum / float64(count)
+}
+
+// StakeWeightedCLV returns the average closing line value, as in CLV, weighted by
+// the stake of each bet, or 0 if there are no such bets.
+func (l *Ledger) StakeWeightedCLV() float64 {
+	sum := 0.0
+	staked := 0.0
+	for _, e := range l.settled() {
+		if e.hasClosing() {
+			sum += e.clv() * e.Bet.Stake()
+			staked += e.Bet.Stake()
+		}
+	}
+	if staked == 0.0 {
+		return 0.0
+	}
+	return sum / staked
+}
+
+// Exposure summarizes the risk of pending bets.
+type Exposure struct {
+	// Staked is the total amount at risk.
+	Staked float64
+	// MaxPayout is the total return, including stakes, if every bet wins.
+	MaxPayout float64
+	// MaxProfit is the net profit of the best outcome of the bets and MaxLoss the
+	// net loss of the worst, with bets on opposing selections offsetting.
+	MaxProfit float64
+	MaxLoss   float64
+}
+
+// selection returns the selection backed by the bet of the entry, that of a
+// Futures if not given, or "" if unknown.
+func (e LedgerEntry) selection() string {
+	if f, ok := e.Bet.(Futures); e.Selection == "" && ok {
+		return f.Selection()
+	}
+	return e.Selection
+}
+
+// Exposure returns the exposure of all of the pending bets.
+func (l *Ledger) Exposure() Exposure {
+	return l.ExposureBy(func(LedgerEntry) string { return "" })[""]
+}
+
+// ExposureBy returns the exposure of the pending bets grouped by the given key.
+// Within a group, the bets on the outcomes of each market with outcomes set by
+// SetMarketOutcomes are netted, exactly one outcome winning, so that bets on
+// opposing outcomes offset. Every other bet is taken to be independent, so may win
+// or lose whatever the others do.
+func (l *Ledger) ExposureBy(key func(LedgerEntry) string) map[string]Exposure {
+	type market struct {
+		key string
+		eventMarket
+	}
+	// winnings holds the return of the netted bets of each market on each outcome,
+	// and staked the total stake of the netted bets of each market.
+	winnings := map[market]map[string]float64{}
+	staked := map[market]float64{}
+	exposures := map[string]Exposure{}
+	for _, e := range l.pending() {
+		k := key(e)
+		stake := e.Bet.Stake()
+		payout := stake * e.Bet.Odds().decimalOdds
+		exposure := exposures[k]
+		exposure.Staked += stake
+		exposure.MaxPayout += payout
+		outcomes := l.marketOutcomes(e)
+		if outcomes == nil {
+			exposure.MaxProfit += payout - stake
+			exposure.MaxLoss += stake
+			exposures[k] = exposure
+			continue
+		}
+		exposures[k] = exposure
+		m := market{k, eventMarket{e.Event, e.Market}}
+		if winnings[m] == nil {
+			winnings[m] = map[string]float64{}
+			for _, o := range outcomes {
+				winnings[m][o] = 0.0
+			}
+		}
+		winnings[m][e.selection()] += payout
+		staked[m] += stake
+	}
+	for m, byOutcome := range winnings {
+		best, worst := math.Inf(-1), math.Inf(1)
+		for _, payout := range byOutcome {
+			best = math.Max(best, payout-staked[m])
+			worst = math.Min(worst, payout-staked[m])
+		}
+		exposure := exposures[m.key]
+		exposure.MaxProfit += best
+		exposure.MaxLoss -= worst
+		exposures[m.key] = exposure
+	}
+	return exposures
+}
+
+// ExposureByEvent returns the exposure of the pending bets by event.
+func (l *Ledger) ExposureByEvent() map[string]Exposure {
+	return l.ExposureBy(func(e LedgerEntry) string { return e.Event })
+}
+
+// ExposureByMarket returns the exposure of the pending bets by market.
+func (l *Ledger) ExposureByMarket() map[string]Exposure {
+	return l.ExposureBy(func(e LedgerEntry) string { return e.Market })
+}
+
+// ExposureByBook returns the exposure of the pending bets by book. The MaxProfit of
+// each is the liability of that book.
+func (l *Ledger) ExposureByBook() map[string]Exposure {
+	return l.ExposureBy(func(e LedgerEntry) string { return e.Book })
+}
+
+// ZScore returns the z-score of the profit of the settled bets against the null
+// hypothesis that each bet was priced at break even, that is that each selection
+// won with the implied probability of its odds. Pushed bets carry no information
+// and are excluded. A z-score of 2 is roughly a 1 in 44 chance of a record at least
+// as good arising from break even betting. It is 0 without any bets to test.
+func (l *Ledger) ZScore() float64 {
+	z, _ := l.zScore()
+	return z
+}
+
+// zScore returns the ZScore and whether there were any bets to test.
+func (l *Ledger) zScore() (float64, bool) {
+	profit := 0.0
+	variance := 0.0
+	for _, e := range l.settled() {
+		if e.Result == Push {
+			continue
+		}
+		stake := e.Bet.Stake()
+		profit += e.Profit()
+		// The profit of a break even bet of s at d has variance s^2 * (d - 1).
+		variance += stake * stake * (e.Bet.Odds().decimalOdds - 1.0)
+	}
+	if variance == 0.0 {
+		return 0.0, false
+	}
+	return profit / math.Sqrt(variance), true
+}
+
+// PValue returns the one sided p-value of the profit of the settled bets against
+// the null hypothesis of break even betting, the probability of a record at least
+// as good arising by chance. See ZScore. It is 1 without any bets to test.
+func (l *Ledger) PValue() float64 {
+	z, ok := l.zScore()
+	if !ok {
+		return 1.0
+	}
+	return 1.0 - normalCDF(z)
+}
@@ -0,0 +1,213 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func dummyLedger() Ledger {
+	l := NewLedger()
+	l.Record(NewStraight(NewOddsFromDecimal(2.0), 100.0), NewOddsFromDecimal(1.9), Win)
+	l.Record(NewStraight(NewOddsFromDecimal(3.0), 50.0), NewOddsFromDecimal(3.0), Loss)
+	l.Record(NewStraight(NewOddsFromDecimal(2.2), 50.0), Odds{}, Push)
+	l.Record(NewParlay(50.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0)), Odds{}, Win, Win)
+	return l
+}
+
+func TestLedger_Record(t *testing.T) {
+	l := NewLedger()
+	entry, err := l.Record(NewStraight(NewOddsFromDecimal(2.0), 100.0), Odds{}, Win)
+	assert.NoError(t, err)
+	assert.Equal(t, Win, entry.Result)
+	assert.Equal(t, 200.0, entry.Payout)
+	assert.Equal(t, 100.0, entry.Profit())
+	assert.Equal(t, 1, l.Len())
+	assert.Equal(t, []LedgerEntry{entry}, l.Entries())
+
+	_, err = l.Record(NewParlay(50.0, NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0)), Odds{}, Win)
+	assert.ErrorIs(t, err, ErrResultCount)
+	assert.Equal(t, 1, l.Len())
+}
+
+func TestLedger_Profit(t *testing.T) {
+	l := dummyLedger()
+	assert.Equal(t, 250.0, l.Staked())
+	assert.Equal(t, 200.0, l.Profit())
+	assert.Equal(t, 8.0, l.UnitsWon(25.0))
+}
+
+func TestLedger_ROI(t *testing.T) {
+	l := dummyLedger()
+	assert.InDelta(t, 0.8, l.ROI(), 1e-9)
+	assert.InDelta(t, 0.75, l.Yield(), 1e-9)
+}
+
+func TestLedger_Empty(t *testing.T) {
+	l := NewLedger()
+	assert.Equal(t, 0.0, l.ROI())
+	assert.Equal(t, 0.0, l.Yield())
+	assert.Equal(t, 0.0, l.CLV())
+	assert.Equal(t, 0.0, l.StakeWeightedCLV())
+
+	// Bets without closing odds have no closing line value.
+	l.Record(NewStraight(NewOddsFromDecimal(2.0), 100.0), Odds{}, Win)
+	assert.Equal(t, 0.0, l.CLV())
+	assert.Equal(t, 0.0, l.StakeWeightedCLV())
+}
+
+func TestLedger_AverageOdds(t *testing.T) {
+	l := dummyLedger()
+	assert.InDelta(t, 2.8, l.AverageOdds().decimalOdds, 1e-9)
+}
+
+func TestLedger_CLV(t *testing.T) {
+	l := dummyLedger()
+	assert.InDelta(t, 0.0263, l.CLV(), 0.0001)
+}
+
+func pendingLedger() Ledger {
+	l := dummyLedger()
+	l.Add(LedgerEntry{Event: "KC@BUF", Market: "spread", Book: "bookA", Selection: "KC -3.5", Bet: NewStraight(NewOddsFromDecimal(2.0), 100.0), Pending: true})
+	l.Add(LedgerEntry{Event: "KC@BUF", Market: "total", Book: "bookB", Bet: NewStraight(NewOddsFromDecimal(1.9), 50.0), Pending: true})
+	l.Add(LedgerEntry{Event: "NYJ@MIA", Market: "spread", Book: "bookA", Bet: NewStraight(NewOddsFromDecimal(3.0), 20.0), Pending: true})
+	return l
+}
+
+func TestLedger_Pending(t *testing.T) {
+	l := pendingLedger()
+	assert.Equal(t, 7, l.Len())
+	assert.Equal(t, 250.0, l.Staked())
+	assert.Equal(t, 200.0, l.Profit())
+
+	_, err := l.Settle(4, NewOddsFromDecimal(1.8))
+	assert.ErrorIs(t, err, ErrResultCount)
+	assert.True(t, l.Entries()[4].Pending)
+
+	entry, err := l.Settle(4, NewOddsFromDecimal(1.8), Win)
+	assert.NoError(t, err)
+	assert.False(t, entry.Pending)
+	_, err = l.Settle(4, NewOddsFromDecimal(1.8), Loss)
+	assert.Error(t, err)
+	_, err = l.Settle(0, Odds{}, Win)
+	assert.Error(t, err)
+	_, err = l.Settle(7, Odds{}, Win)
+	assert.Error(t, err)
+	_, err = l.Settle(-1, Odds{}, Win)
+	assert.Error(t, err)
+	assert.Equal(t, 200.0, entry.Payout)
+	assert.Equal(t, 350.0, l.Staked())
+	assert.Equal(t, 300.0, l.Profit())
+}
+
+func TestLedger_Exposure(t *testing.T) {
+	l := pendingLedger()
+	exposure := l.Exposure()
+	assert.InDelta(t, 170.0, exposure.Staked, 1e-9)
+	assert.InDelta(t, 355.0, exposure.MaxPayout, 1e-9)
+	assert.InDelta(t, 185.0, exposure.MaxProfit, 1e-9)
+	assert.InDelta(t, 170.0, exposure.MaxLoss, 1e-9)
+
+	byEvent := l.ExposureByEvent()
+	assert.Len(t, byEvent, 2)
+	assert.InDelta(t, 150.0, byEvent["KC@BUF"].Staked, 1e-9)
+	assert.InDelta(t, 295.0, byEvent["KC@BUF"].MaxPayout, 1e-9)
+	assert.InDelta(t, 150.0, byEvent["KC@BUF"].MaxLoss, 1e-9)
+
+	byMarket := l.ExposureByMarket()
+	assert.InDelta(t, 120.0, byMarket["spread"].Staked, 1e-9)
+
+	byBook := l.ExposureByBook()
+	assert.InDelta(t, 140.0, byBook["bookA"].MaxProfit, 1e-9)
+	assert.InDelta(t, 45.0, byBook["bookB"].MaxProfit, 1e-9)
+}
+
+func TestLedger_ExposureNetted(t *testing.T) {
+	l := NewLedger()
+	l.Add(LedgerEntry{Event: "NYJ@MIA", Market: "moneyline", Book: "bookA", Selection: "NYJ", Bet: NewStraight(NewOddsFromDecimal(2.5), 100.0), Pending: true})
+	l.Add(LedgerEntry{Event: "NYJ@MIA", Market: "moneyline", Book: "bookB", Selection: "MIA", Bet: NewStraight(NewOddsFromDecimal(1.8), 100.0), Pending: true})
+	l.Add(LedgerEntry{Event: "NYJ@MIA", Market: "total", Book: "bookA", Bet: NewStraight(NewOddsFromDecimal(2.0), 10.0), Pending: true})
+
+	// Without the outcomes of the moneyline both sides may lose.
+	assert.InDelta(t, 210.0, l.ExposureByEvent()["NYJ@MIA"].MaxLoss, 1e-9)
+
+	// NYJ winning nets 50 and MIA winning loses 20, while the total wins or loses 10.
+	l.SetMarketOutcomes("NYJ@MIA", "moneyline", "NYJ", "MIA")
+	byEvent := l.ExposureByEvent()
+	assert.InDelta(t, 210.0, byEvent["NYJ@MIA"].Staked, 1e-9)
+	assert.InDelta(t, 60.0, byEvent["NYJ@MIA"].MaxProfit, 1e-9)
+	assert.InDelta(t, 30.0, byEvent["NYJ@MIA"].MaxLoss, 1e-9)
+
+	byMarket := l.ExposureByMarket()
+	assert.InDelta(t, 50.0, byMarket["moneyline"].MaxProfit, 1e-9)
+	assert.InDelta(t, 20.0, byMarket["moneyline"].MaxLoss, 1e-9)
+
+	// Each book holds one side, so nothing offsets.
+	byBook := l.ExposureByBook()
+	assert.InDelta(t, 160.0, byBook["bookA"].MaxProfit, 1e-9)
+	assert.InDelta(t, 110.0, byBook["bookA"].MaxLoss, 1e-9)
+	assert.InDelta(t, 100.0, byBook["bookB"].MaxLoss, 1e-9)
+
+	// Bets of unknown selection are not taken to oppose each other.
+	l.Add(LedgerEntry{Event: "NYJ@MIA", Market: "total", Book: "bookB", Bet: NewStraight(NewOddsFromDecimal(2.0), 10.0), Pending: true})
+	assert.InDelta(t, 40.0, l.ExposureByEvent()["NYJ@MIA"].MaxLoss, 1e-9)
+}
+
+func TestLedger_ExposureUnbacked(t *testing.T) {
+	// Backing home and draw loses both if away wins.
+	l := NewLedger()
+	l.Add(LedgerEntry{Event: "ARS@CHE", Market: "1x2", Selection: "home", Bet: NewStraight(NewOddsFromDecimal(2.5), 100.0), Pending: true})
+	l.Add(LedgerEntry{Event: "ARS@CHE", Market: "1x2", Selection: "draw", Bet: NewStraight(NewOddsFromDecimal(3.5), 100.0), Pending: true})
+	l.SetMarketOutcomes("ARS@CHE", "1x2", "home", "draw", "away")
+	exposure := l.Exposure()
+	assert.InDelta(t, 200.0, exposure.MaxLoss, 1e-9)
+	assert.InDelta(t, 150.0, exposure.MaxProfit, 1e-9)
+
+	// Unrelated futures without an event or market may both lose, or both win.
+	l = NewLedger()
+	l.Add(LedgerEntry{Bet: NewFutures("Chiefs SB", NewOddsFromDecimal(6.0), 100.0), Pending: true})
+	l.Add(LedgerEntry{Bet: NewFutures("Yankees WS", NewOddsFromDecimal(8.0), 100.0), Pending: true})
+	l.SetMarketOutcomes("", "", "Chiefs SB", "Yankees WS")
+	exposure = l.Exposure()
+	assert.InDelta(t, 200.0, exposure.MaxLoss, 1e-9)
+	assert.InDelta(t, 1200.0, exposure.MaxProfit, 1e-9)
+}
+
+func TestLedger_StakeWeightedCLV(t *testing.T) {
+	l := dummyLedger()
+	// 100 staked at 0.0526 and 50 staked at 0.0.
+	assert.InDelta(t, 0.0351, l.StakeWeightedCLV(), 0.0001)
+}
+
+func TestLedger_ZScore(t *testing.T) {
+	// 55-45 at -110 with equal stakes.
+	l := NewLedger()
+	for i := 0; i < 100; i++ {
+		result := Loss
+		if i < 55 {
+			result = Win
+		}
+		l.Record(NewStraight(NewOddsFromAmerican(-110.0), 110.0), Odds{}, result)
+	}
+	l.Record(NewStraight(NewOddsFromAmerican(-110.0), 110.0), Odds{}, Push)
+	assert.InDelta(t, 0.5244, l.ZScore(), 0.0001)
+	assert.InDelta(t, 0.3000, l.PValue(), 0.0001)
+
+	// Without any bets, or only pushes, there is nothing to test.
+	l = NewLedger()
+	assert.Equal(t, 0.0, l.ZScore())
+	assert.Equal(t, 1.0, l.PValue())
+	l.Record(NewStraight(NewOddsFromAmerican(-110.0), 110.0), Odds{}, Push)
+	assert.Equal(t, 0.0, l.ZScore())
+	assert.Equal(t, 1.0, l.PValue())
+}
+
+func TestLedger_All(t *testing.T) {
+	l := dummyLedger()
+	for i, e := range l.All() {
+		assert.Equal(t, l.Entries()[i], e)
+	}
+	odds := slices.Collect(l.Values())
+	assert.Len(t, odds, 4)
+	assert.Equal(t, NewOddsFromDecimal(4.0), odds[3])
+}
@@ -0,0 +1,117 @@
+package wagering
+
+import "math"
+
+// MarginDistribution is the distribution of the error of the final margin of
+// victory of a side about its expected margin, the negative of its point spread,
+// used to convert between spreads, moneylines and alternate lines.
+type MarginDistribution interface {
+	// CDF returns the probability that the error is at most x.
+	CDF(x float64) float64
+	// Quantile returns the error at or below which the error falls with probability
+	// p, the inverse of CDF.
+	Quantile(p float64) float64
+}
+
+// NFLMarginSigma is the standard deviation of NFL final margins about the spread.
+// http://www.stat.yale.edu/~pollard/Courses/241.fall2014/notes2014/Stern_NFL.pdf
+const NFLMarginSigma = 13.86
+
+// NormalMargin is a MarginDistribution of normally distributed errors with the
+// given standard deviation, a sport specific value such as NFLMarginSigma.
+type NormalMargin struct {
+	Sigma float64
+}
+
+// CDF returns the probability that the error is at most x.
+func (nm NormalMargin) CDF(x float64) float64 {
+	return normalCDF(x / nm.Sigma)
+}
+
+// Quantile returns the error at or below which the error falls with probability p.
+func (nm NormalMargin) Quantile(p float64) float64 {
+	return nm.Sigma * normalQuantile(p)
+}
+
+// SpreadWinProb returns the probability that a side favored or not by the point
+// spread, such as -7 for a seven point favorite, wins outright under dist.
+func SpreadWinProb(spread float64, dist MarginDistribution) Probability {
+	// The side wins when -spread + error > 0.
+	return NewProbabilityFromDecimal(1.0 - dist.CDF(spread))
+}
+
+// SpreadToMoneyline returns the fair moneyline odds of a side with the given point
+// spread and of its opponent under dist.
+func SpreadToMoneyline(spread float64, dist MarginDistribution) (odds, opponentOdds Odds) {
+	p := SpreadWinProb(spread, dist).decimal
+	return NewOddsFromDecimal(1.0 / p), NewOddsFromDecimal(1.0 / (1.0 - p))
+}
+
+// MoneylineToSpread returns the point spread of a side with the given fair
+// probability of winning outright under dist. It is the inverse of SpreadWinProb.
+func MoneylineToSpread(prob Probability, dist MarginDistribution) float64 {
+	return dist.Quantile(1.0 - prob.decimal)
+}
+
+// AltLine is the fair price of an alternate line.
+type AltLine struct {
+	Line float64
+	// Prob is the probability that the side, or the over, covers the line.
+	Prob Probability
+	// Odds are the fair odds of the side, or the over.
+	Odds Odds
+	// OpponentOdds are the fair odds of the opponent, or the under.
+	OpponentOdds Odds
+}
+
+// newAltLine returns the AltLine at line covered with probability p.
+func newAltLine(line, p float64) AltLine {
+	return AltLine{
+		Line:         line,
+		Prob:         NewProbabilityFromDecimal(p),
+		Odds:         NewOddsFromDecimal(1.0 / p),
+		OpponentOdds: NewOddsFromDecimal(1.0 / (1.0 - p)),
+	}
+}
+
+// LineLadder returns the lines from through to, inclusive, in steps of step, such
+// as LineLadder(-2.5, -10.5, 1.0) for -2.5, -3.5 through -10.5. The sign of step
+// is ignored, the direction following from and to, and a zero step returns nil.
+func LineLadder(from, to, step float64) []float64 {
+	step = math.Abs(step)
+	if !(step > 0.0) {
+		return nil
+	}
+	var lines []float64
+	if from > to {
+		step = -step
+	}
+	n := int((to-from)/step + 1e-9)
+	for i := 0; i <= n; i++ {
+		lines = append(lines, from+float64(i)*step)
+	}
+	return lines
+}
+
+// AltSpreads returns the fair prices of a side at each of the alternate point
+// spread lines given the side's main spread and the distribution of the margin
+// about it. Pushes on whole number lines are not modeled.
+func AltSpreads(spread float64, dist MarginDistribution, lines ...float64) []AltLine {
+	var alts []AltLine
+	for _, l := range lines {
+		// The side covers l when -spread + error + l > 0.
+		alts = append(alts, newAltLine(l, 1.0-dist.CDF(spread-l)))
+	}
+	return alts
+}
+
+// AltTotals returns the fair prices of the over and under at each of the alternate
+// total lines given the main total and the distribution of the combined score
+// about it. Pushes on whole number lines are not modeled.
+func AltTotals(total float64, dist MarginDistribution, lines ...float64) []AltLine {
+	var alts []AltLine
+	for _, l := range lines {
+		alts = append(alts, newAltLine(l, 1.0-dist.CDF(l-total)))
+	}
+	return alts
+}
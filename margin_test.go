@@ -0,0 +1,54 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestSpreadToMoneyline(t *testing.T) {
+	dist := NormalMargin{Sigma: NFLMarginSigma}
+	assert.InDelta(t, 0.5, SpreadWinProb(0.0, dist).decimal, 1e-12)
+	assert.InDelta(t, 0.6932, SpreadWinProb(-7.0, dist).decimal, 0.0001)
+
+	odds, opponentOdds := SpreadToMoneyline(-7.0, dist)
+	assert.InDelta(t, -226.0, odds.American(), 1.0)
+	assert.InDelta(t, 226.0, opponentOdds.American(), 1.0)
+}
+
+func TestMoneylineToSpread(t *testing.T) {
+	dist := NormalMargin{Sigma: NFLMarginSigma}
+	assert.InDelta(t, -7.0, MoneylineToSpread(SpreadWinProb(-7.0, dist), dist), 1e-9)
+	assert.InDelta(t, 3.0, MoneylineToSpread(SpreadWinProb(3.0, dist), dist), 1e-9)
+	assert.InDelta(t, 0.0, MoneylineToSpread(NewProbabilityFromDecimal(0.5), dist), 1e-12)
+}
+
+func TestLineLadder(t *testing.T) {
+	assert.Equal(t, []float64{-2.5, -3.5, -4.5}, LineLadder(-2.5, -4.5, 1.0))
+	assert.Equal(t, []float64{44.5, 45.0, 45.5}, LineLadder(44.5, 45.5, 0.5))
+	assert.Equal(t, []float64{-2.5, -3.5, -4.5}, LineLadder(-2.5, -4.5, -1.0))
+	assert.Nil(t, LineLadder(-2.5, -4.5, 0.0))
+	assert.Nil(t, LineLadder(-2.5, -4.5, math.NaN()))
+}
+
+func TestAltSpreads(t *testing.T) {
+	dist := NormalMargin{Sigma: NFLMarginSigma}
+	alts := AltSpreads(-6.5, dist, LineLadder(-2.5, -10.5, 1.0)...)
+	assert.Len(t, alts, 9)
+	assert.Equal(t, -2.5, alts[0].Line)
+	assert.InDelta(t, 0.5, alts[4].Prob.decimal, 1e-12)
+	assert.InDelta(t, 2.0, alts[4].Odds.Decimal(), 1e-12)
+	for i := 1; i < len(alts); i++ {
+		assert.Less(t, alts[i].Prob.decimal, alts[i-1].Prob.decimal)
+	}
+	assert.InDelta(t, 0.6135, alts[0].Prob.decimal, 0.0001)
+	assert.InDelta(t, 1.0, 1.0/alts[0].Odds.Decimal()+1.0/alts[0].OpponentOdds.Decimal(), 1e-12)
+}
+
+func TestAltTotals(t *testing.T) {
+	dist := NormalMargin{Sigma: 10.0}
+	alts := AltTotals(44.5, dist, 40.5, 44.5, 48.5)
+	assert.InDelta(t, 0.6554, alts[0].Prob.decimal, 0.0001)
+	assert.InDelta(t, 0.5, alts[1].Prob.decimal, 1e-12)
+	assert.InDelta(t, 0.3446, alts[2].Prob.decimal, 0.0001)
+}
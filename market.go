@@ -0,0 +1,79 @@
+package wagering
+
+import (
+	"iter"
+)
+
+// Outcome is a named outcome of a Market and its odds.
+type Outcome struct {
+	Name string
+	Odds Odds
+}
+
+// Market is the odds of each outcome of a market on an event, such as the
+// moneyline of a game, quoted by a book. A fair market, as from devigging or a
+// model, has no margin and may have no book.
+type Market struct {
+	// Event identifies the event, such as "NYJ @ NE".
+	Event string
+	// Name identifies the market of the event, such as "moneyline".
+	Name     string
+	Book     string
+	Outcomes []Outcome
+}
+
+// Odds returns the odds of each outcome in order.
+func (m Market) Odds() []Odds {
+	var odds []Odds
+	for _, o := range m.Outcomes {
+		odds = append(odds, o.Odds)
+	}
+	return odds
+}
+
+// All returns an iterator over the name and odds of each outcome in order.
+func (m Market) All() iter.Seq2[string, Odds] {
+	return func(yield func(string, Odds) bool) {
+		for _, o := range m.Outcomes {
+			if !yield(o.Name, o.Odds) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the odds of each outcome in order.
+func (m Market) Values() iter.Seq[Odds] {
+	return func(yield func(Odds) bool) {
+		for _, o := range m.Outcomes {
+			if !yield(o.Odds) {
+				return
+			}
+		}
+	}
+}
+
+// Outcome returns the outcome with the given name and whether it was found.
+func (m Market) Outcome(name string) (Outcome, bool) {
+	for _, o := range m.Outcomes {
+		if o.Name == name {
+			return o, true
+		}
+	}
+	return Outcome{}, false
+}
+
+// Devig returns the fair market made by removing the margin with method. The book
+// of the fair market is that of m.
+func (m Market) Devig(method DevigMethod) Market {
+	fair := Market{Event: m.Event, Name: m.Name, Book: m.Book}
+	for i, o := range Devig(method, m.Odds()...) {
+		fair.Outcomes = append(fair.Outcomes, Outcome{Name: m.Outcomes[i].Name, Odds: o})
+	}
+	return fair
+}
+
+// sameMarket returns whether m and other are the same market of the same event.
+func (m Market) sameMarket(other Market) bool {
+	return m.Event == other.Event && m.Name == other.Name
+}
@@ -0,0 +1,50 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func sampleMarket(book string, home, away float64) Market {
+	return Market{
+		Event: "NYJ @ NE",
+		Name:  "moneyline",
+		Book:  book,
+		Outcomes: []Outcome{
+			{Name: "NE", Odds: NewOddsFromAmerican(home)},
+			{Name: "NYJ", Odds: NewOddsFromAmerican(away)},
+		},
+	}
+}
+
+func TestMarket(t *testing.T) {
+	m := sampleMarket("pinnacle", -150.0, +130.0)
+	assert.Equal(t, []Odds{NewOddsFromAmerican(-150.0), NewOddsFromAmerican(+130.0)}, m.Odds())
+
+	o, ok := m.Outcome("NYJ")
+	assert.True(t, ok)
+	assert.Equal(t, +130.0, o.Odds.American())
+	_, ok = m.Outcome("MIA")
+	assert.False(t, ok)
+
+	fair := m.Devig(Multiplicative)
+	assert.Equal(t, "pinnacle", fair.Book)
+	assert.Equal(t, "NE", fair.Outcomes[0].Name)
+	assert.InDelta(t, 1.0, probSum(fair.Odds()...), 1e-12)
+}
+
+func TestMarket_All(t *testing.T) {
+	m := sampleMarket("pinnacle", -150.0, +130.0)
+	var names []string
+	for name, odds := range m.All() {
+		names = append(names, name)
+		assert.Equal(t, odds, m.Outcomes[len(names)-1].Odds)
+	}
+	assert.Equal(t, []string{"NE", "NYJ"}, names)
+	assert.Equal(t, m.Odds(), slices.Collect(m.Values()))
+	for odds := range m.Values() {
+		assert.Equal(t, -150.0, odds.American())
+		break
+	}
+}
@@ -0,0 +1,155 @@
+package wagering
+
+import (
+	"fmt"
+	"math"
+)
+
+// minorExponents holds the number of decimal places of the minor unit of the
+// currencies that do not use two.
+var minorExponents = map[string]int{
+	"BHD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+}
+
+// minorExponent returns the number of decimal places of the minor unit of the
+// given currency.
+func minorExponent(currency string) int {
+	if e, ok := minorExponents[currency]; ok {
+		return e
+	}
+	return 2
+}
+
+// Money is an amount of a currency held as an integer count of the currency's
+// minor unit, such as cents, so that settlement and totals do not suffer from
+// floating point drift.
+type Money struct {
+	minor    int64
+	currency string
+}
+
+// NewMoney returns the Money for amount, in major units such as dollars, of the
+// given currency, rounded to the nearest minor unit.
+func NewMoney(amount float64, currency string) Money {
+	scale := math.Pow(10, float64(minorExponent(currency)))
+	return Money{minor: int64(math.Round(amount * scale)), currency: currency}
+}
+
+// NewMoneyFromMinor returns the Money for minor units, such as cents, of the given
+// currency.
+func NewMoneyFromMinor(minor int64, currency string) Money {
+	return Money{minor: minor, currency: currency}
+}
+
+// Minor returns the amount in minor units.
+func (m Money) Minor() int64 {
+	return m.minor
+}
+
+// Currency returns the currency code.
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Float64 returns the amount in major units for use in calculations.
+func (m Money) Float64() float64 {
+	return float64(m.minor) / math.Pow(10, float64(minorExponent(m.currency)))
+}
+
+// Add returns the sum of m and other, or an error if their currencies differ.
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("currency mismatch %s and %s", m.currency, other.currency)
+	}
+	return Money{minor: m.minor + other.minor, currency: m.currency}, nil
+}
+
+// Sub returns m less other, or an error if their currencies differ.
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Neg())
+}
+
+// Neg returns the negation of m.
+func (m Money) Neg() Money {
+	return Money{minor: -m.minor, currency: m.currency}
+}
+
+// Mul returns m multiplied by factor, rounded to the nearest minor unit.
+func (m Money) Mul(factor float64) Money {
+	return Money{minor: int64(math.Round(float64(m.minor) * factor)), currency: m.currency}
+}
+
+// String returns the amount in major units followed by the currency code.
+func (m Money) String() string {
+	return fmt.Sprintf("%.*f %s", minorExponent(m.currency), m.Float64(), m.currency)
+}
+
+// Payout returns the total returned, including the stake, of a winning wager of
+// stake at odds, rounded to the nearest minor unit.
+func (odds Odds) Payout(stake Money) Money {
+	return stake.Mul(odds.decimalOdds)
+}
+
+// SettleMoney settles bet, as Bet.Settle, in the given currency, returning the
+// total return, including stake, rounded once to the nearest minor unit as a book
+// pays it.
+func SettleMoney(bet Bet, currency string, results ...Result) (Result, Money, error) {
+	result, payout, err := bet.Settle(results...)
+	if err != nil {
+		return result, Money{}, err
+	}
+	return result, NewMoney(payout, currency), nil
+}
+
+// StakeMoney returns the amount wagered on the entry in the given currency, rounded
+// to the nearest minor unit.
+func (e LedgerEntry) StakeMoney(currency string) Money {
+	return NewMoney(e.Bet.Stake(), currency)
+}
+
+// PayoutMoney returns the total returned, including stake, of the settled entry in
+// the given currency, rounded to the nearest minor unit.
+func (e LedgerEntry) PayoutMoney(currency string) Money {
+	return NewMoney(e.Payout, currency)
+}
+
+// ProfitMoney returns the net profit of the settled entry in the given currency,
+// its PayoutMoney less its StakeMoney, so that the profit of a ledger is exactly
+// what was returned less what was staked.
+func (e LedgerEntry) ProfitMoney(currency string) Money {
+	return NewMoneyFromMinor(e.PayoutMoney(currency).minor-e.StakeMoney(currency).minor, currency)
+}
+
+// StakedMoney returns the total amount wagered on settled bets in the given
+// currency, summing the StakeMoney of each.
+func (l *Ledger) StakedMoney(currency string) Money {
+	total := NewMoneyFromMinor(0, currency)
+	for _, e := range l.settled() {
+		total.minor += e.StakeMoney(currency).minor
+	}
+	return total
+}
+
+// PayoutMoney returns the total returned, including stakes, of settled bets in the
+// given currency, summing the PayoutMoney of each.
+func (l *Ledger) PayoutMoney(currency string) Money {
+	total := NewMoneyFromMinor(0, currency)
+	for _, e := range l.settled() {
+		total.minor += e.PayoutMoney(currency).minor
+	}
+	return total
+}
+
+// ProfitMoney returns the total net profit of settled bets in the given currency,
+// summing the ProfitMoney of each, so that it is exactly the PayoutMoney less the
+// StakedMoney.
+func (l *Ledger) ProfitMoney(currency string) Money {
+	total := NewMoneyFromMinor(0, currency)
+	for _, e := range l.settled() {
+		total.minor += e.ProfitMoney(currency).minor
+	}
+	return total
+}
@@ -0,0 +1,61 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMoney(t *testing.T) {
+	a := NewMoney(0.1, "USD")
+	b := NewMoney(0.2, "USD")
+	sum, err := a.Add(b)
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoneyFromMinor(30, "USD"), sum)
+	assert.Equal(t, 0.3, sum.Float64())
+	assert.Equal(t, "0.30 USD", sum.String())
+
+	diff, err := a.Sub(b)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), diff.Minor())
+
+	_, err = a.Add(NewMoney(0.1, "EUR"))
+	assert.Error(t, err)
+
+	yen := NewMoney(1234.5, "JPY")
+	assert.Equal(t, int64(1235), yen.Minor())
+	assert.Equal(t, "1235 JPY", yen.String())
+	assert.Equal(t, "JPY", yen.Currency())
+}
+
+func TestOdds_Payout(t *testing.T) {
+	odds := NewOddsFromAmerican(-110.0)
+	assert.Equal(t, NewMoney(19.09, "USD"), odds.Payout(NewMoney(10.0, "USD")))
+}
+
+func TestLedger_Money(t *testing.T) {
+	l := dummyLedger()
+	assert.Equal(t, NewMoney(250.0, "USD"), l.StakedMoney("USD"))
+	assert.Equal(t, NewMoney(200.0, "USD"), l.ProfitMoney("USD"))
+	assert.Equal(t, NewMoney(450.0, "USD"), l.PayoutMoney("USD"))
+
+	// A stake of half a cent rounds up, but its profit is what was returned less
+	// what was staked rather than the rounded profit.
+	l = NewLedger()
+	for i := 0; i < 3; i++ {
+		l.Record(NewStraight(NewOddsFromDecimal(2.0), 0.125), Odds{}, Win)
+	}
+	assert.Equal(t, int64(39), l.StakedMoney("USD").Minor())
+	assert.Equal(t, int64(75), l.PayoutMoney("USD").Minor())
+	assert.Equal(t, int64(36), l.ProfitMoney("USD").Minor())
+}
+
+func TestSettleMoney(t *testing.T) {
+	parlay := NewParlay(10.0, NewOddsFromAmerican(-110.0), NewOddsFromAmerican(-110.0))
+	result, payout, err := SettleMoney(parlay, "USD", Win, Win)
+	assert.NoError(t, err)
+	assert.Equal(t, Win, result)
+	assert.Equal(t, int64(3645), payout.Minor())
+
+	_, _, err = SettleMoney(parlay, "USD", Win)
+	assert.ErrorIs(t, err, ErrResultCount)
+}
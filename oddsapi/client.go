@@ -0,0 +1,247 @@
+// Package oddsapi is a client for The Odds API, https://the-odds-api.com, that maps
+// its responses into wagering Markets.
+package oddsapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/dburger/wagering"
+)
+
+// DefaultBaseURL is the base URL of version 4 of The Odds API.
+const DefaultBaseURL = "https://api.the-odds-api.com/v4"
+
+// Event is a sporting event and the markets quoted on it by each bookmaker.
+type Event struct {
+	ID           string
+	Sport        string
+	CommenceTime time.Time
+	HomeTeam     string
+	AwayTeam     string
+	// Markets holds a Market for each market of each bookmaker, with Event
+	// "AwayTeam @ HomeTeam", Name the market key, such as "h2h", and Book the
+	// bookmaker key. The names of outcomes with a point, as of spreads and totals,
+	// are suffixed with the point, such as "New England Patriots -3.5" or
+	// "Over 45.5".
+	Markets []wagering.Market
+}
+
+// Market returns the market with the given key quoted by book and whether it was
+// found.
+func (e Event) Market(book, key string) (wagering.Market, bool) {
+	for _, m := range e.Markets {
+		if m.Book == book && m.Name == key {
+			return m, true
+		}
+	}
+	return wagering.Market{}, false
+}
+
+// OddsRequest selects the odds to fetch. Empty fields are omitted, leaving the API
+// defaults.
+type OddsRequest struct {
+	// Regions are the regions of the bookmakers, such as "us" or "uk". At least
+	// one of Regions or Bookmakers is required by the API.
+	Regions []string
+	// Markets are the market keys, such as "h2h", "spreads" or "totals".
+	Markets []string
+	// Bookmakers are the bookmaker keys, such as "pinnacle", taking precedence over
+	// Regions.
+	Bookmakers []string
+}
+
+// APIError is an error response of the API.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("oddsapi: %d: %s", e.StatusCode, e.Message)
+}
+
+// Client fetches odds from The Odds API. It is safe for concurrent use.
+type Client struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+	remaining  atomic.Int64
+}
+
+// NewClient constructs a new Client authenticating with apiKey against
+// DefaultBaseURL using http.DefaultClient.
+func NewClient(apiKey string) *Client {
+	return NewClientWithBaseURL(apiKey, DefaultBaseURL, http.DefaultClient)
+}
+
+// NewClientWithBaseURL constructs a new Client authenticating with apiKey against
+// baseURL using httpClient.
+func NewClientWithBaseURL(apiKey, baseURL string, httpClient *http.Client) *Client {
+	c := &Client{apiKey: apiKey, baseURL: strings.TrimSuffix(baseURL, "/"), httpClient: httpClient}
+	c.remaining.Store(-1)
+	return c
+}
+
+// Remaining returns the number of requests remaining in the quota as of the last
+// response, or -1 if unknown.
+func (c *Client) Remaining() int {
+	return int(c.remaining.Load())
+}
+
+// Odds returns the upcoming and live events of sport, a sport key such as
+// "americanfootball_nfl", with the odds selected by req.
+func (c *Client) Odds(ctx context.Context, sport string, req OddsRequest) ([]Event, error) {
+	query := url.Values{}
+	query.Set("apiKey", c.apiKey)
+	query.Set("oddsFormat", "decimal")
+	setList(query, "regions", req.Regions)
+	setList(query, "markets", req.Markets)
+	setList(query, "bookmakers", req.Bookmakers)
+	u := fmt.Sprintf("%s/sports/%s/odds?%s", c.baseURL, url.PathEscape(sport), query.Encode())
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, redact(err)
+	}
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, redact(err)
+	}
+	defer resp.Body.Close()
+	if remaining, err := strconv.Atoi(resp.Header.Get("x-requests-remaining")); err == nil {
+		c.remaining.Store(int64(remaining))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, apiError(resp)
+	}
+	return ParseOdds(resp.Body)
+}
+
+// maxErrorBody is the most of an error response body read for its message.
+const maxErrorBody = 64 << 10
+
+// apiError returns the APIError of an error response, with the message of its JSON
+// body, else the body itself if not JSON, else the status text.
+func apiError(resp *http.Response) error {
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if err != nil {
+		return fmt.Errorf("oddsapi: reading %d response: %w", resp.StatusCode, err)
+	}
+	var body struct {
+		Message string `json:"message"`
+	}
+	message := ""
+	if err := json.Unmarshal(data, &body); err == nil {
+		message = body.Message
+	} else {
+		message = strings.TrimSpace(string(data))
+	}
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+	return &APIError{StatusCode: resp.StatusCode, Message: message}
+}
+
+// redact returns err with the apiKey query parameter replaced in the URL of a
+// *url.Error, as returned by http.Client.Do, so that the key is not leaked into
+// logs.
+func redact(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		urlErr.URL = ""
+		return err
+	}
+	query := u.Query()
+	if query.Has("apiKey") {
+		query.Set("apiKey", "REDACTED")
+		u.RawQuery = query.Encode()
+	}
+	urlErr.URL = u.String()
+	return err
+}
+
+// setList sets key of query to the comma separated values, if any.
+func setList(query url.Values, key string, values []string) {
+	if len(values) > 0 {
+		query.Set(key, strings.Join(values, ","))
+	}
+}
+
+// apiEvent is the JSON representation of an event with odds.
+type apiEvent struct {
+	ID           string    `json:"id"`
+	SportKey     string    `json:"sport_key"`
+	CommenceTime time.Time `json:"commence_time"`
+	HomeTeam     string    `json:"home_team"`
+	AwayTeam     string    `json:"away_team"`
+	Bookmakers   []struct {
+		Key     string `json:"key"`
+		Markets []struct {
+			Key      string `json:"key"`
+			Outcomes []struct {
+				Name  string   `json:"name"`
+				Price float64  `json:"price"`
+				Point *float64 `json:"point"`
+			} `json:"outcomes"`
+		} `json:"markets"`
+	} `json:"bookmakers"`
+}
+
+// ParseOdds parses the JSON response of the odds endpoint, requested with decimal
+// odds, as from a saved response.
+func ParseOdds(r io.Reader) ([]Event, error) {
+	var apiEvents []apiEvent
+	if err := json.NewDecoder(r).Decode(&apiEvents); err != nil {
+		return nil, err
+	}
+	var events []Event
+	for _, ae := range apiEvents {
+		e := Event{
+			ID:           ae.ID,
+			Sport:        ae.SportKey,
+			CommenceTime: ae.CommenceTime,
+			HomeTeam:     ae.HomeTeam,
+			AwayTeam:     ae.AwayTeam,
+		}
+		name := ae.AwayTeam + " @ " + ae.HomeTeam
+		for _, b := range ae.Bookmakers {
+			for _, am := range b.Markets {
+				m := wagering.Market{Event: name, Name: am.Key, Book: b.Key}
+				for _, o := range am.Outcomes {
+					outcome := o.Name
+					if o.Point != nil {
+						outcome += " " + formatPoint(am.Key, *o.Point)
+					}
+					m.Outcomes = append(m.Outcomes, wagering.Outcome{Name: outcome, Odds: wagering.NewOddsFromDecimal(o.Price)})
+				}
+				e.Markets = append(e.Markets, m)
+			}
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// formatPoint formats the point of an outcome of the market with key, signed
+// unless a total.
+func formatPoint(key string, point float64) string {
+	s := strconv.FormatFloat(point, 'f', -1, 64)
+	if point >= 0.0 && !strings.Contains(key, "totals") {
+		s = "+" + s
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package oddsapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleOdds = `[{
+	"id": "e912304de2b2ce35b473ce2ecd3d1502",
+	"sport_key": "americanfootball_nfl",
+	"commence_time": "2024-09-08T17:00:00Z",
+	"home_team": "New England Patriots",
+	"away_team": "New York Jets",
+	"bookmakers": [{
+		"key": "pinnacle",
+		"title": "Pinnacle",
+		"markets": [{
+			"key": "h2h",
+			"outcomes": [
+				{"name": "New England Patriots", "price": 1.67},
+				{"name": "New York Jets", "price": 2.3}
+			]
+		}, {
+			"key": "spreads",
+			"outcomes": [
+				{"name": "New England Patriots", "price": 1.91, "point": -3.5},
+				{"name": "New York Jets", "price": 1.91, "point": 3.5}
+			]
+		}, {
+			"key": "totals",
+			"outcomes": [
+				{"name": "Over", "price": 1.95, "point": 45.5},
+				{"name": "Under", "price": 1.87, "point": 45.5}
+			]
+		}]
+	}]
+}]`
+
+func TestParseOdds(t *testing.T) {
+	events, err := ParseOdds(strings.NewReader(sampleOdds))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	e := events[0]
+	assert.Equal(t, "americanfootball_nfl", e.Sport)
+	assert.Equal(t, time.Date(2024, 9, 8, 17, 0, 0, 0, time.UTC), e.CommenceTime)
+	assert.Len(t, e.Markets, 3)
+
+	m, ok := e.Market("pinnacle", "h2h")
+	assert.True(t, ok)
+	assert.Equal(t, "New York Jets @ New England Patriots", m.Event)
+	assert.Equal(t, "New England Patriots", m.Outcomes[0].Name)
+	assert.Equal(t, 1.67, m.Outcomes[0].Odds.Decimal())
+
+	m, _ = e.Market("pinnacle", "spreads")
+	assert.Equal(t, "New England Patriots -3.5", m.Outcomes[0].Name)
+	assert.Equal(t, "New York Jets +3.5", m.Outcomes[1].Name)
+	m, _ = e.Market("pinnacle", "totals")
+	assert.Equal(t, "Over 45.5", m.Outcomes[0].Name)
+
+	_, ok = e.Market("draftkings", "h2h")
+	assert.False(t, ok)
+
+	_, err = ParseOdds(strings.NewReader("{"))
+	assert.Error(t, err)
+}
+
+func TestClient_Odds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/sports/americanfootball_nfl/odds", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "decimal", query.Get("oddsFormat"))
+		assert.Equal(t, "us,eu", query.Get("regions"))
+		assert.Equal(t, "h2h", query.Get("markets"))
+		assert.Equal(t, "", query.Get("bookmakers"))
+		switch query.Get("apiKey") {
+		case "key":
+		case "throttled":
+			http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			return
+		case "empty":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message": "API key is not valid"}`))
+			return
+		}
+		w.Header().Set("x-requests-remaining", "499")
+		w.Write([]byte(sampleOdds))
+	}))
+	defer server.Close()
+
+	req := OddsRequest{Regions: []string{"us", "eu"}, Markets: []string{"h2h"}}
+	client := NewClientWithBaseURL("key", server.URL+"/v4/", server.Client())
+	assert.Equal(t, -1, client.Remaining())
+	events, err := client.Odds(context.Background(), "americanfootball_nfl", req)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, 499, client.Remaining())
+
+	client = NewClientWithBaseURL("bad", server.URL+"/v4", server.Client())
+	_, err = client.Odds(context.Background(), "americanfootball_nfl", req)
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "oddsapi: 401: API key is not valid", err.Error())
+
+	client = NewClientWithBaseURL("throttled", server.URL+"/v4", server.Client())
+	_, err = client.Odds(context.Background(), "americanfootball_nfl", req)
+	assert.Equal(t, "oddsapi: 429: Too many requests", err.Error())
+	client = NewClientWithBaseURL("empty", server.URL+"/v4", server.Client())
+	_, err = client.Odds(context.Background(), "americanfootball_nfl", req)
+	assert.Equal(t, "oddsapi: 500: Internal Server Error", err.Error())
+
+	// A Client may be shared across goroutines.
+	client = NewClientWithBaseURL("key", server.URL+"/v4", server.Client())
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Odds(context.Background(), "americanfootball_nfl", req)
+			assert.NoError(t, err)
+			client.Remaining()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 499, client.Remaining())
+
+	// Transport errors do not leak the key.
+	server.Close()
+	client = NewClientWithBaseURL("secret", server.URL+"/v4", server.Client())
+	_, err = client.Odds(context.Background(), "americanfootball_nfl", req)
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "apiKey=REDACTED")
+}
@@ -0,0 +1,160 @@
+package wagering
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+)
+
+// OddsColumns maps the columns of a CSV file of historical odds, with a header row
+// followed by one row per quote, to the fields of a Quote by header name. Optional
+// columns may be empty to leave the field empty.
+type OddsColumns struct {
+	// Book, Event and Market are the optional columns of the book, event and market
+	// of each quote.
+	Book   string
+	Event  string
+	Market string
+	// Outcome and Price are the required columns of the outcome and its odds.
+	Outcome string
+	Price   string
+	// Time is the optional column of when each price was quoted, in TimeLayout.
+	Time string
+	// TimeLayout is the layout of times, time.RFC3339 if empty.
+	TimeLayout string
+	// Format is the optional column of the odds format of each price, "american"
+	// or "decimal". Without it, or where empty, prices are in DefaultFormat.
+	Format string
+	// DefaultFormat is the odds format of prices without a format, "decimal" if
+	// empty.
+	DefaultFormat string
+}
+
+// ReadOddsCSV reads the quotes of a CSV file of historical odds, such as from a
+// public dataset, from r with columns mapped by columns, in file order.
+func ReadOddsCSV(r io.Reader, columns OddsColumns) ([]Quote, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	for i, name := range header {
+		index[name] = i
+	}
+	// column returns the index of the named column, -1 if unmapped, recording the
+	// first missing column.
+	var missing error
+	column := func(name string, required bool) int {
+		if name == "" && !required {
+			return -1
+		}
+		i, ok := index[name]
+		if !ok && missing == nil {
+			missing = fmt.Errorf("missing column %q", name)
+		}
+		return i
+	}
+	book, event, market := column(columns.Book, false), column(columns.Event, false), column(columns.Market, false)
+	outcome, price := column(columns.Outcome, true), column(columns.Price, true)
+	at, format := column(columns.Time, false), column(columns.Format, false)
+	if missing != nil {
+		return nil, missing
+	}
+	layout := columns.TimeLayout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	defaultFormat := columns.DefaultFormat
+	if defaultFormat == "" {
+		defaultFormat = decimalFormat
+	}
+	var quotes []Quote
+	for row := 2; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return quotes, nil
+		} else if err != nil {
+			return nil, err
+		}
+		// field returns the value of column i of the record, empty if unmapped.
+		field := func(i int) string {
+			if i < 0 {
+				return ""
+			}
+			return record[i]
+		}
+		q := Quote{Book: field(book), Event: field(event), Market: field(market), Outcome: field(outcome)}
+		f := field(format)
+		if f == "" {
+			f = defaultFormat
+		}
+		if q.Odds, err = parseOdds(field(price), f); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		if t := field(at); t != "" {
+			if q.Time, err = time.Parse(layout, t); err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+		}
+		quotes = append(quotes, q)
+	}
+}
+
+// QuoteKey identifies the outcome of a market of an event quoted by a book.
+type QuoteKey struct {
+	Event   string
+	Market  string
+	Book    string
+	Outcome string
+}
+
+// Key returns the key identifying the quoted outcome.
+func (q Quote) Key() QuoteKey {
+	return QuoteKey{Event: q.Event, Market: q.Market, Book: q.Book, Outcome: q.Outcome}
+}
+
+// QuoteSeries returns the series of the odds of each quoted outcome.
+func QuoteSeries(quotes ...Quote) map[QuoteKey]OddsSeries {
+	series := make(map[QuoteKey]OddsSeries)
+	for _, q := range quotes {
+		s := series[q.Key()]
+		s.Add(q.Time, q.Odds)
+		series[q.Key()] = s
+	}
+	return series
+}
+
+// QuoteMarkets returns the market of the latest odds of each outcome of each book's
+// markets, the later of quotes at the same time. Markets and their outcomes are
+// ordered by first appearance in quotes.
+func QuoteMarkets(quotes ...Quote) []Market {
+	var markets []Market
+	index := make(map[bookMarket]int)
+	latest := make(map[QuoteKey]time.Time)
+	for _, q := range quotes {
+		key := bookMarket{q.Event, q.Market, q.Book}
+		i, ok := index[key]
+		if !ok {
+			i = len(markets)
+			index[key] = i
+			markets = append(markets, Market{Event: q.Event, Name: q.Market, Book: q.Book})
+		}
+		m := &markets[i]
+		j := 0
+		for j < len(m.Outcomes) && m.Outcomes[j].Name != q.Outcome {
+			j++
+		}
+		if j == len(m.Outcomes) {
+			m.Outcomes = append(m.Outcomes, Outcome{Name: q.Outcome})
+		} else if q.Time.Before(latest[q.Key()]) {
+			continue
+		}
+		m.Outcomes[j].Odds = q.Odds
+		latest[q.Key()] = q.Time
+	}
+	return markets
+}
@@ -0,0 +1,70 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleOddsCSV = `timestamp,bookmaker,game,bet_type,selection,line,odds_format
+2024-09-08 09:00,pinnacle,NYJ @ NE,moneyline,NE,-150,american
+2024-09-08 09:00,pinnacle,NYJ @ NE,moneyline,NYJ,+130,american
+2024-09-08 12:00,pinnacle,NYJ @ NE,moneyline,NE,-160,american
+2024-09-08 10:00,bet365,NYJ @ NE,moneyline,NE,1.62,
+2024-09-08 09:30,pinnacle,NYJ @ NE,moneyline,NE,-155,american
+2024-09-08 12:00,pinnacle,NYJ @ NE,moneyline,NYJ,+140,american
+`
+
+var sampleOddsColumns = OddsColumns{
+	Book:       "bookmaker",
+	Event:      "game",
+	Market:     "bet_type",
+	Outcome:    "selection",
+	Price:      "line",
+	Time:       "timestamp",
+	TimeLayout: "2006-01-02 15:04",
+	Format:     "odds_format",
+}
+
+func TestReadOddsCSV(t *testing.T) {
+	quotes, err := ReadOddsCSV(strings.NewReader(sampleOddsCSV), sampleOddsColumns)
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 6)
+	assert.Equal(t, QuoteKey{Event: "NYJ @ NE", Market: "moneyline", Book: "pinnacle", Outcome: "NE"}, quotes[0].Key())
+	assert.Equal(t, -150.0, quotes[0].Odds.American())
+	assert.Equal(t, time.Date(2024, 9, 8, 9, 0, 0, 0, time.UTC), quotes[0].Time)
+	assert.Equal(t, 1.62, quotes[3].Odds.Decimal())
+
+	quotes, err = ReadOddsCSV(strings.NewReader("selection,price\nNE,1.5\n"), OddsColumns{Outcome: "selection", Price: "price"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Quote{{Outcome: "NE", Odds: NewOddsFromDecimal(1.5)}}, quotes)
+
+	_, err = ReadOddsCSV(strings.NewReader(sampleOddsCSV), OddsColumns{Outcome: "selection", Price: "price"})
+	assert.EqualError(t, err, `missing column "price"`)
+	_, err = ReadOddsCSV(strings.NewReader("selection,price\nNE,x\n"), OddsColumns{Outcome: "selection", Price: "price"})
+	assert.ErrorContains(t, err, "row 2")
+	quotes, err = ReadOddsCSV(strings.NewReader(""), sampleOddsColumns)
+	assert.NoError(t, err)
+	assert.Empty(t, quotes)
+}
+
+func TestQuoteSeries(t *testing.T) {
+	quotes, _ := ReadOddsCSV(strings.NewReader(sampleOddsCSV), sampleOddsColumns)
+	series := QuoteSeries(quotes...)
+	assert.Len(t, series, 3)
+	ne := series[QuoteKey{Event: "NYJ @ NE", Market: "moneyline", Book: "pinnacle", Outcome: "NE"}]
+	assert.Equal(t, 3, ne.Len())
+	assert.Equal(t, -150.0, ne.Open().American())
+	assert.Equal(t, -160.0, ne.Close().American())
+}
+
+func TestQuoteMarkets(t *testing.T) {
+	quotes, _ := ReadOddsCSV(strings.NewReader(sampleOddsCSV), sampleOddsColumns)
+	markets := QuoteMarkets(quotes...)
+	assert.Len(t, markets, 2)
+	assert.Equal(t, "pinnacle", markets[0].Book)
+	assert.Equal(t, []Odds{NewOddsFromAmerican(-160.0), NewOddsFromAmerican(+140.0)}, markets[0].Odds())
+	assert.Equal(t, "bet365", markets[1].Book)
+	assert.Equal(t, "NE", markets[1].Outcomes[0].Name)
+}
@@ -0,0 +1,105 @@
+package wagering
+
+import (
+	"fmt"
+	"math"
+)
+
+// LineMove compares the opening and closing markets of an event.
+type LineMove struct {
+	Event  string
+	Market string
+	// HoldChange is the closing hold less the opening hold, as percents.
+	HoldChange float64
+	// Drift holds the closing fair probability less the opening fair probability,
+	// as decimals, of each outcome.
+	Drift []float64
+	// Toward is the outcome the market moved toward, the one with the largest
+	// Drift.
+	Toward string
+	// OpenFavorite and CloseFavorite are the outcomes with the largest fair
+	// probability in the opening and closing markets.
+	OpenFavorite  string
+	CloseFavorite string
+}
+
+// favorite returns the name of the outcome with the largest probability.
+func favorite(outcomes []Outcome, probs []Probability) string {
+	best := 0
+	for i, p := range probs {
+		if p.decimal > probs[best].decimal {
+			best = i
+		}
+	}
+	return outcomes[best].Name
+}
+
+// CompareOpenClose returns the LineMove from the opening to the closing market of
+// an event, devigging each with method. An error is returned when the markets do
+// not have the same outcomes in the same order.
+func CompareOpenClose(opening, closing Market, method DevigMethod) (LineMove, error) {
+	if len(opening.Outcomes) != len(closing.Outcomes) || len(opening.Outcomes) == 0 {
+		return LineMove{}, fmt.Errorf("opening market has %d outcomes, closing market %d", len(opening.Outcomes), len(closing.Outcomes))
+	}
+	for i, o := range opening.Outcomes {
+		if o.Name != closing.Outcomes[i].Name {
+			return LineMove{}, fmt.Errorf("opening outcome %q does not match closing outcome %q", o.Name, closing.Outcomes[i].Name)
+		}
+	}
+	openProbs := DevigProbs(method, opening.Odds()...)
+	closeProbs := DevigProbs(method, closing.Odds()...)
+	move := LineMove{
+		Event:         opening.Event,
+		Market:        opening.Name,
+		HoldChange:    HoldPercent(closing.Odds()...) - HoldPercent(opening.Odds()...),
+		OpenFavorite:  favorite(opening.Outcomes, openProbs),
+		CloseFavorite: favorite(closing.Outcomes, closeProbs),
+	}
+	toward := 0
+	for i := range openProbs {
+		move.Drift = append(move.Drift, closeProbs[i].decimal-openProbs[i].decimal)
+		if move.Drift[i] > move.Drift[toward] {
+			toward = i
+		}
+	}
+	move.Toward = opening.Outcomes[toward].Name
+	return move, nil
+}
+
+// OpenCloseSummary aggregates the LineMoves of many events.
+type OpenCloseSummary struct {
+	Events int
+	// MeanHoldChange is the mean change of the hold, as a percent.
+	MeanHoldChange float64
+	// MeanAbsDrift is the mean absolute drift of the fair probability of every
+	// outcome.
+	MeanAbsDrift float64
+	// FavoriteHeld is the fraction of events where the opening favorite was also the
+	// closing favorite, how often openers predict closers.
+	FavoriteHeld float64
+}
+
+// SummarizeOpenClose returns the summary of the given LineMoves.
+func SummarizeOpenClose(moves ...LineMove) OpenCloseSummary {
+	summary := OpenCloseSummary{Events: len(moves)}
+	if len(moves) == 0 {
+		return summary
+	}
+	drifts := 0
+	for _, m := range moves {
+		summary.MeanHoldChange += m.HoldChange
+		for _, d := range m.Drift {
+			summary.MeanAbsDrift += math.Abs(d)
+			drifts++
+		}
+		if m.OpenFavorite == m.CloseFavorite {
+			summary.FavoriteHeld++
+		}
+	}
+	summary.MeanHoldChange /= float64(len(moves))
+	summary.FavoriteHeld /= float64(len(moves))
+	if drifts > 0 {
+		summary.MeanAbsDrift /= float64(drifts)
+	}
+	return summary
+}
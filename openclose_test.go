@@ -0,0 +1,39 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCompareOpenClose(t *testing.T) {
+	opening := sampleMarket("bookA", -110.0, -110.0)
+	closing := sampleMarket("bookA", -125.0, +105.0)
+	move, err := CompareOpenClose(opening, closing, Multiplicative)
+	assert.NoError(t, err)
+	assert.Equal(t, "NYJ @ NE", move.Event)
+	assert.Equal(t, "NE", move.Toward)
+	assert.InDelta(t, 0.0325, move.Drift[0], 0.0001)
+	assert.InDelta(t, -move.Drift[0], move.Drift[1], 1e-12)
+	assert.InDelta(t, -0.3896, move.HoldChange, 0.0001)
+	assert.Equal(t, "NE", move.CloseFavorite)
+
+	closing.Outcomes = closing.Outcomes[:1]
+	_, err = CompareOpenClose(opening, closing, Multiplicative)
+	assert.Error(t, err)
+	closing = sampleMarket("bookA", -125.0, +105.0)
+	closing.Outcomes[1].Name = "MIA"
+	_, err = CompareOpenClose(opening, closing, Multiplicative)
+	assert.Error(t, err)
+}
+
+func TestSummarizeOpenClose(t *testing.T) {
+	first, _ := CompareOpenClose(sampleMarket("bookA", -130.0, +110.0), sampleMarket("bookA", -140.0, +120.0), Multiplicative)
+	second, _ := CompareOpenClose(sampleMarket("bookA", -105.0, -115.0), sampleMarket("bookA", -120.0, +100.0), Multiplicative)
+	summary := SummarizeOpenClose(first, second)
+	assert.Equal(t, 2, summary.Events)
+	assert.Equal(t, 0.5, summary.FavoriteHeld)
+	assert.InDelta(t, (first.HoldChange+second.HoldChange)/2.0, summary.MeanHoldChange, 1e-12)
+	assert.InDelta(t, (2.0*first.Drift[0]+2.0*second.Drift[0])/4.0, summary.MeanAbsDrift, 1e-12)
+
+	assert.Equal(t, OpenCloseSummary{}, SummarizeOpenClose())
+}
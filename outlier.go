@@ -0,0 +1,87 @@
+package wagering
+
+import (
+	"math"
+	"sort"
+)
+
+// Dispersion is a measure of the spread of quotes about their consensus.
+type Dispersion int
+
+const (
+	// MedianAbsoluteDeviation measures the spread about the median, scaled to match
+	// the standard deviation of normally distributed quotes, and is robust to the
+	// outliers being detected.
+	MedianAbsoluteDeviation Dispersion = iota
+	// StandardDeviation measures the spread about the mean.
+	StandardDeviation
+)
+
+// madScale scales the median absolute deviation to estimate the standard
+// deviation of normally distributed values.
+const madScale = 1.4826
+
+// Outlier is a quote far from the consensus of the quotes for the same outcome.
+type Outlier struct {
+	Book string
+	Odds Odds
+	// Score is the number of dispersions the implied probability of the quote is
+	// below the consensus, positive when the price is longer than the consensus, a
+	// potential value spot, and negative when shorter.
+	Score float64
+}
+
+// Outliers returns the quotes, a map from book to the odds it quotes for the same
+// outcome, whose implied probability is more than k dispersions from the consensus,
+// ordered from the largest absolute Score. Outliers are either errors or value. If
+// the dispersion is zero every quote differing from the consensus is an outlier
+// with an infinite Score.
+func Outliers(quotes map[string]Odds, k float64, dispersion Dispersion) []Outlier {
+	var probs []float64
+	for _, o := range quotes {
+		probs = append(probs, o.ImpliedProb().decimal)
+	}
+	if len(probs) == 0 {
+		return nil
+	}
+	var consensus, scale float64
+	switch dispersion {
+	case StandardDeviation:
+		consensus = mean(probs)
+		for _, p := range probs {
+			scale += (p - consensus) * (p - consensus)
+		}
+		scale = math.Sqrt(scale / float64(len(probs)))
+	default:
+		consensus = quantile(sorted(probs), 0.5)
+		var deviations []float64
+		for _, p := range probs {
+			deviations = append(deviations, math.Abs(p-consensus))
+		}
+		scale = madScale * quantile(sorted(deviations), 0.5)
+	}
+	var outliers []Outlier
+	for b, o := range quotes {
+		diff := consensus - o.ImpliedProb().decimal
+		if diff == 0.0 {
+			continue
+		}
+		score := math.Inf(1)
+		if diff < 0.0 {
+			score = math.Inf(-1)
+		}
+		if scale > 0.0 {
+			score = diff / scale
+		}
+		if math.Abs(score) > k {
+			outliers = append(outliers, Outlier{Book: b, Odds: o, Score: score})
+		}
+	}
+	sort.Slice(outliers, func(i, j int) bool {
+		if math.Abs(outliers[i].Score) != math.Abs(outliers[j].Score) {
+			return math.Abs(outliers[i].Score) > math.Abs(outliers[j].Score)
+		}
+		return outliers[i].Book < outliers[j].Book
+	})
+	return outliers
+}
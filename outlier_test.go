@@ -0,0 +1,40 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestOutliers(t *testing.T) {
+	quotes := map[string]Odds{
+		"bookA": NewOddsFromAmerican(-110.0),
+		"bookB": NewOddsFromAmerican(-112.0),
+		"bookC": NewOddsFromAmerican(-108.0),
+		"bookD": NewOddsFromAmerican(-110.0),
+		"bookE": NewOddsFromAmerican(+130.0),
+	}
+	outliers := Outliers(quotes, 3.0, MedianAbsoluteDeviation)
+	assert.Len(t, outliers, 1)
+	assert.Equal(t, "bookE", outliers[0].Book)
+	assert.Greater(t, outliers[0].Score, 3.0)
+
+	// The outlier inflates the standard deviation, masking itself at the same k.
+	assert.Empty(t, Outliers(quotes, 3.0, StandardDeviation))
+	outliers = Outliers(quotes, 1.5, StandardDeviation)
+	assert.Len(t, outliers, 1)
+	assert.Equal(t, "bookE", outliers[0].Book)
+
+	quotes = map[string]Odds{
+		"bookA": NewOddsFromAmerican(-110.0),
+		"bookB": NewOddsFromAmerican(-110.0),
+		"bookC": NewOddsFromAmerican(-110.0),
+		"bookD": NewOddsFromAmerican(-140.0),
+	}
+	outliers = Outliers(quotes, 3.0, MedianAbsoluteDeviation)
+	assert.Len(t, outliers, 1)
+	assert.Equal(t, "bookD", outliers[0].Book)
+	assert.True(t, math.IsInf(outliers[0].Score, -1))
+
+	assert.Nil(t, Outliers(map[string]Odds{}, 3.0, StandardDeviation))
+}
@@ -0,0 +1,221 @@
+package wagering
+
+import (
+	"fmt"
+	"math"
+)
+
+// Leg is a single selection of a multi-leg wager, pairing the offered odds with
+// the estimated probability that the selection wins.
+type Leg struct {
+	Odds Odds
+	Prob Probability
+}
+
+// ParlayOdds returns the combined Odds of a parlay of the given Odds.
+func ParlayOdds(odds ...Odds) Odds {
+	decimalOdds := 1.0
+	for _, o := range odds {
+		decimalOdds *= o.decimalOdds
+	}
+	return NewOddsFromDecimal(decimalOdds)
+}
+
+func legOdds(legs []Leg) []Odds {
+	var odds []Odds
+	for _, l := range legs {
+		odds = append(odds, l.Odds)
+	}
+	return odds
+}
+
+// CorrelatedParlayProb returns the probability that every leg wins given the
+// pairwise correlations between the legs. corr must be a len(legs) x len(legs)
+// matrix where corr[i][j] is the correlation between the outcomes of legs i and
+// j; the diagonal is ignored. An error is returned if corr is not of that size.
+//
+// The joint probability is computed with the second order Bahadur expansion of
+// the correlated Bernoulli outcomes, which is exact for two legs. The result is
+// clamped to the range [0, min(p)] as higher order interactions are not modeled.
+// https://en.wikipedia.org/wiki/Bahadur_representation
+func CorrelatedParlayProb(legs []Leg, corr [][]float64) (Probability, error) {
+	if len(corr) != len(legs) {
+		return Probability{}, fmt.Errorf("correlations of %d legs given for %d legs", len(corr), len(legs))
+	}
+	for i, row := range corr {
+		if len(row) != len(legs) {
+			return Probability{}, fmt.Errorf("correlations of leg %d has %d entries for %d legs", i, len(row), len(legs))
+		}
+	}
+	if len(legs) == 0 {
+		return NewProbabilityFromDecimal(0.0), nil
+	}
+	independent := 1.0
+	minProb := 1.0
+	z := make([]float64, len(legs))
+	for i, l := range legs {
+		p := l.Prob.decimal
+		independent *= p
+		minProb = math.Min(minProb, p)
+		if p > 0.0 {
+			z[i] = math.Sqrt((1.0 - p) / p)
+		}
+	}
+	adjustment := 1.0
+	for i := 0; i < len(legs); i++ {
+		for j := i + 1; j < len(legs); j++ {
+			adjustment += corr[i][j] * z[i] * z[j]
+		}
+	}
+	joint := math.Min(math.Max(independent*adjustment, 0.0), minProb)
+	return NewProbabilityFromDecimal(joint), nil
+}
+
+// CorrelatedParlayEV returns the long term expected value of a parlay of the given
+// legs with the given pairwise correlations between them. See CorrelatedParlayProb
+// for the form of corr. The result is given as the percent increase or decrease
+// (negative) of the wager.
+func CorrelatedParlayEV(legs []Leg, corr [][]float64) (float64, error) {
+	prob, err := CorrelatedParlayProb(legs, corr)
+	if err != nil {
+		return 0.0, err
+	}
+	return ParlayOdds(legOdds(legs)...).ExpectedValueProb(prob), nil
+}
+
+// JointDistribution maps combinations of selection outcomes to their probability,
+// allowing correlated selections, such as those of a same game parlay, to be priced
+// exactly from a model. Bit i of a key is set when selection i wins in that
+// combination. The probabilities need not be normalized.
+type JointDistribution map[uint64]float64
+
+// Prob returns the probability that all of the given selections win. An error is
+// returned for a selection outside of [0, 64), as it has no bit of a key.
+func (jd JointDistribution) Prob(selections ...int) (Probability, error) {
+	var mask uint64
+	for _, s := range selections {
+		if s < 0 || s >= 64 {
+			return Probability{}, fmt.Errorf("selection %d outside of [0, 64)", s)
+		}
+		mask |= 1 << uint(s)
+	}
+	total := 0.0
+	wins := 0.0
+	for outcomes, p := range jd {
+		total += p
+		if outcomes&mask == mask {
+			wins += p
+		}
+	}
+	if total == 0.0 {
+		return NewProbabilityFromDecimal(0.0), nil
+	}
+	return NewProbabilityFromDecimal(wins / total), nil
+}
+
+// JointParlay returns the fair odds of a parlay of the given selections under the
+// joint distribution, and the expected value of wagering the parlay at the quoted
+// odds. The expected value is given as the percent increase or decrease (negative)
+// of the wager. See JointDistribution.Prob for the errors returned.
+func JointParlay(jd JointDistribution, quoted Odds, selections ...int) (fair Odds, ev float64, err error) {
+	prob, err := jd.Prob(selections...)
+	if err != nil {
+		return Odds{}, 0.0, err
+	}
+	return NewOddsFromDecimal(1 / prob.decimal), quoted.ExpectedValueProb(prob), nil
+}
+
+// combinations returns every combination of k indices drawn from [0, n) in
+// lexicographic order.
+func combinations(n, k int) [][]int {
+	var combos [][]int
+	if k <= 0 || k > n {
+		return combos
+	}
+	combo := make([]int, k)
+	for i := range combo {
+		combo[i] = i
+	}
+	for {
+		combos = append(combos, append([]int(nil), combo...))
+		i := k - 1
+		for i >= 0 && combo[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return combos
+		}
+		combo[i]++
+		for j := i + 1; j < k; j++ {
+			combo[j] = combo[j-1] + 1
+		}
+	}
+}
+
+// RoundRobinParlay is one of the parlays making up a round robin.
+type RoundRobinParlay struct {
+	// Legs holds the indices of the legs of the round robin in this parlay.
+	Legs []int
+	Odds Odds
+}
+
+// RoundRobinBet is the set of parlays making up a round robin.
+type RoundRobinBet struct {
+	Parlays []RoundRobinParlay
+}
+
+// RoundRobin returns the round robin of every parlay of size legs that can be
+// made from the given legs.
+func RoundRobin(legs []Odds, size int) RoundRobinBet {
+	var rr RoundRobinBet
+	for _, combo := range combinations(len(legs), size) {
+		var odds []Odds
+		for _, i := range combo {
+			odds = append(odds, legs[i])
+		}
+		rr.Parlays = append(rr.Parlays, RoundRobinParlay{Legs: combo, Odds: ParlayOdds(odds...)})
+	}
+	return rr
+}
+
+// TotalStake returns the total amount wagered on the round robin when each parlay
+// is wagered stake.
+func (rr RoundRobinBet) TotalStake(stake float64) float64 {
+	return stake * float64(len(rr.Parlays))
+}
+
+// PayoutRange returns the smallest non zero payout, when exactly the legs of the
+// shortest parlay win, and the largest payout, when every leg wins, of the round
+// robin when each parlay is wagered stake. Payouts include the returned stake.
+func (rr RoundRobinBet) PayoutRange(stake float64) (min, max float64) {
+	for i, p := range rr.Parlays {
+		payout := stake * p.Odds.decimalOdds
+		if i == 0 || payout < min {
+			min = payout
+		}
+		max += payout
+	}
+	return min, max
+}
+
+// ParlayHedge returns the stake to wager at hedgeOdds against the final leg of a
+// parlay with the given potential payout, including stake, so that the same profit
+// is made whether or not the final leg wins, and that profit. The original parlay
+// stake is considered sunk and is not deducted from the profit.
+func ParlayHedge(payout float64, hedgeOdds Odds) (stake, profit float64) {
+	stake = payout / hedgeOdds.decimalOdds
+	return stake, stake * (hedgeOdds.decimalOdds - 1.0)
+}
+
+// ParlayHedgeKelly returns the stake to wager at hedgeOdds against the final leg of
+// a parlay with the given potential payout, including stake, that maximizes the
+// expected log of the bankroll, where prob is the probability that the final leg
+// of the parlay wins. The bankroll excludes the parlay. The result is between zero,
+// no hedge, and the bankroll.
+// https://en.wikipedia.org/wiki/Kelly_criterion
+func ParlayHedgeKelly(payout float64, hedgeOdds Odds, prob Probability, bankroll float64) float64 {
+	q := prob.decimal
+	profitMult := hedgeOdds.decimalOdds - 1.0
+	stake := (1.0-q)*(bankroll+payout) - q*bankroll/profitMult
+	return math.Min(math.Max(stake, 0.0), bankroll)
+}
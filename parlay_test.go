@@ -0,0 +1,127 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParlayOdds(t *testing.T) {
+	odds := ParlayOdds(NewOddsFromDecimal(2.0), NewOddsFromDecimal(1.5), NewOddsFromAmerican(-110.0))
+	assert.InDelta(t, 5.7273, odds.decimalOdds, 0.0001)
+}
+
+func TestCorrelatedParlayProb(t *testing.T) {
+	legs := []Leg{
+		{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.5)},
+		{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.5)},
+	}
+	prob := func(corr [][]float64) float64 {
+		p, err := CorrelatedParlayProb(legs, corr)
+		assert.NoError(t, err)
+		return p.decimal
+	}
+	assert.InDelta(t, 0.25, prob([][]float64{{1.0, 0.0}, {0.0, 1.0}}), 1e-9)
+	assert.InDelta(t, 0.5, prob([][]float64{{1.0, 1.0}, {1.0, 1.0}}), 1e-9)
+	assert.InDelta(t, 0.0, prob([][]float64{{1.0, -1.0}, {-1.0, 1.0}}), 1e-9)
+
+	for _, corr := range [][][]float64{nil, {{1.0, 0.0}}, {{1.0, 0.0}, {0.0}}} {
+		_, err := CorrelatedParlayProb(legs, corr)
+		assert.Error(t, err, corr)
+	}
+}
+
+func TestCorrelatedParlayEV(t *testing.T) {
+	legs := []Leg{
+		{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.5)},
+		{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.5)},
+	}
+	ev, err := CorrelatedParlayEV(legs, [][]float64{{1.0, 0.0}, {0.0, 1.0}})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.0, ev, 1e-9)
+	ev, err = CorrelatedParlayEV(legs, [][]float64{{1.0, 0.5}, {0.5, 1.0}})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.5, ev, 1e-9)
+	_, err = CorrelatedParlayEV(legs, [][]float64{{1.0}})
+	assert.Error(t, err)
+}
+
+// sampleJointDistribution returns a joint distribution over a team win (bit 0)
+// and the game going over (bit 1) where the two are positively correlated.
+func sampleJointDistribution() JointDistribution {
+	return JointDistribution{
+		0b00: 0.30,
+		0b01: 0.20,
+		0b10: 0.15,
+		0b11: 0.35,
+	}
+}
+
+func TestJointDistribution_Prob(t *testing.T) {
+	jd := sampleJointDistribution()
+	prob := func(selections ...int) float64 {
+		p, err := jd.Prob(selections...)
+		assert.NoError(t, err)
+		return p.decimal
+	}
+	assert.InDelta(t, 0.55, prob(0), 1e-9)
+	assert.InDelta(t, 0.50, prob(1), 1e-9)
+	assert.InDelta(t, 0.35, prob(0, 1), 1e-9)
+	assert.InDelta(t, 1.0, prob(), 1e-9)
+	assert.InDelta(t, 0.0, prob(63), 1e-9)
+
+	for _, s := range []int{-1, 64, 100} {
+		_, err := jd.Prob(0, s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestJointParlay(t *testing.T) {
+	fair, ev, err := JointParlay(sampleJointDistribution(), NewOddsFromAmerican(+250.0), 0, 1)
+	assert.NoError(t, err)
+	assert.InDelta(t, 2.8571, fair.decimalOdds, 0.0001)
+	assert.InDelta(t, 0.225, ev, 1e-9)
+
+	_, _, err = JointParlay(sampleJointDistribution(), NewOddsFromAmerican(+250.0), 0, 64)
+	assert.Error(t, err)
+}
+
+func TestCombinations(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}, combinations(4, 2))
+	assert.Equal(t, [][]int{{0, 1, 2}}, combinations(3, 3))
+	assert.Empty(t, combinations(2, 3))
+}
+
+func TestRoundRobin(t *testing.T) {
+	legs := []Odds{NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0), NewOddsFromDecimal(4.0)}
+	rr := RoundRobin(legs, 2)
+	assert.Len(t, rr.Parlays, 3)
+	assert.Equal(t, []int{1, 2}, rr.Parlays[2].Legs)
+	assert.InDelta(t, 12.0, rr.Parlays[2].Odds.decimalOdds, 1e-9)
+	assert.Equal(t, 30.0, rr.TotalStake(10.0))
+
+	min, max := rr.PayoutRange(10.0)
+	assert.InDelta(t, 60.0, min, 1e-9)
+	assert.InDelta(t, 260.0, max, 1e-9)
+}
+
+func TestParlayHedge(t *testing.T) {
+	stake, profit := ParlayHedge(1000.0, NewOddsFromAmerican(-150.0))
+	assert.InDelta(t, 600.0, stake, 1e-9)
+	assert.InDelta(t, 400.0, profit, 1e-9)
+}
+
+func TestParlayHedgeKelly(t *testing.T) {
+	hedgeOdds := NewOddsFromDecimal(2.0)
+	// A fair hedge fully equalizes the outcomes.
+	stake := ParlayHedgeKelly(1000.0, hedgeOdds, NewProbabilityFromDecimal(0.5), 1000.0)
+	assert.InDelta(t, 500.0, stake, 1e-9)
+	// The bankroll after either result is equal.
+	assert.InDelta(t, 1000.0+1000.0-stake, 1000.0+stake, 1e-9)
+
+	// Likely parlay wins need little hedging.
+	stake = ParlayHedgeKelly(1000.0, hedgeOdds, NewProbabilityFromDecimal(0.8), 1000.0)
+	assert.InDelta(t, 0.0, stake, 1e-9)
+
+	stake = ParlayHedgeKelly(1000.0, hedgeOdds, NewProbabilityFromDecimal(0.6), 1000.0)
+	assert.InDelta(t, 200.0, stake, 1e-9)
+}
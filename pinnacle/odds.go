@@ -0,0 +1,129 @@
+// Package pinnacle parses the odds feed of the Pinnacle API into wagering Markets,
+// Pinnacle being the usual sharp anchor of devig workflows.
+package pinnacle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/dburger/wagering"
+)
+
+// Book is the book of the parsed markets.
+const Book = "pinnacle"
+
+// OddsFormat is the format of the prices of a feed, as requested by its
+// oddsFormat parameter.
+type OddsFormat int
+
+const (
+	// American is the default format of the feed.
+	American OddsFormat = iota
+	// Decimal is requested by an oddsFormat of "Decimal".
+	Decimal
+)
+
+// Teams names the sides of an event, as given by the fixtures feed.
+type Teams struct {
+	Home string
+	Away string
+}
+
+// feed is the JSON representation of the odds feed.
+type feed struct {
+	Leagues []struct {
+		Events []struct {
+			ID      int64 `json:"id"`
+			Periods []struct {
+				Number    int `json:"number"`
+				Moneyline *struct {
+					Home float64  `json:"home"`
+					Away float64  `json:"away"`
+					Draw *float64 `json:"draw"`
+				} `json:"moneyline"`
+				Spreads []struct {
+					Hdp  float64 `json:"hdp"`
+					Home float64 `json:"home"`
+					Away float64 `json:"away"`
+				} `json:"spreads"`
+				Totals []struct {
+					Points float64 `json:"points"`
+					Over   float64 `json:"over"`
+					Under  float64 `json:"under"`
+				} `json:"totals"`
+			} `json:"periods"`
+		} `json:"events"`
+	} `json:"leagues"`
+}
+
+// ParseOdds parses the JSON of the odds feed, with prices in format, into a Market
+// for the moneyline, each spread and each total of each period of each event, in
+// feed order. Events named in teams have Event "Away @ Home" and outcomes named by
+// team, others have Event the event ID and outcomes "Home" and "Away".
+//
+// Markets are named "moneyline", "spread" and "total" for the full game, period
+// 0, and suffixed with the period otherwise, such as "spread period 1". Outcomes of
+// spreads are suffixed with the handicap of the side, such as "NE -3.5", and those
+// of totals are "Over" and "Under" suffixed with the points. The moneyline of a
+// sport with draws has a "Draw" outcome between those of the home and away sides.
+func ParseOdds(r io.Reader, format OddsFormat, teams map[int64]Teams) ([]wagering.Market, error) {
+	var f feed
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, err
+	}
+	odds := func(price float64) wagering.Odds {
+		if format == Decimal {
+			return wagering.NewOddsFromDecimal(price)
+		}
+		return wagering.NewOddsFromAmerican(price)
+	}
+	var markets []wagering.Market
+	for _, l := range f.Leagues {
+		for _, e := range l.Events {
+			event := strconv.FormatInt(e.ID, 10)
+			home, away := "Home", "Away"
+			if t, ok := teams[e.ID]; ok {
+				event = t.Away + " @ " + t.Home
+				home, away = t.Home, t.Away
+			}
+			for _, p := range e.Periods {
+				market := func(name string, outcomes ...wagering.Outcome) {
+					if p.Number != 0 {
+						name = fmt.Sprintf("%s period %d", name, p.Number)
+					}
+					markets = append(markets, wagering.Market{Event: event, Name: name, Book: Book, Outcomes: outcomes})
+				}
+				if ml := p.Moneyline; ml != nil {
+					outcomes := []wagering.Outcome{{Name: home, Odds: odds(ml.Home)}}
+					if ml.Draw != nil {
+						outcomes = append(outcomes, wagering.Outcome{Name: "Draw", Odds: odds(*ml.Draw)})
+					}
+					market("moneyline", append(outcomes, wagering.Outcome{Name: away, Odds: odds(ml.Away)})...)
+				}
+				for _, s := range p.Spreads {
+					market("spread",
+						wagering.Outcome{Name: home + " " + signed(s.Hdp), Odds: odds(s.Home)},
+						wagering.Outcome{Name: away + " " + signed(-s.Hdp), Odds: odds(s.Away)})
+				}
+				for _, t := range p.Totals {
+					points := strconv.FormatFloat(t.Points, 'f', -1, 64)
+					market("total",
+						wagering.Outcome{Name: "Over " + points, Odds: odds(t.Over)},
+						wagering.Outcome{Name: "Under " + points, Odds: odds(t.Under)})
+				}
+			}
+		}
+	}
+	return markets, nil
+}
+
+// signed formats handicap with its sign, "+0" for a pick'em.
+func signed(handicap float64) string {
+	if handicap >= 0.0 {
+		return "+" + strconv.FormatFloat(math.Abs(handicap), 'f', -1, 64)
+	}
+	return strconv.FormatFloat(handicap, 'f', -1, 64)
+}
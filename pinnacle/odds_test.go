@@ -0,0 +1,80 @@
+package pinnacle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleFeed = `{
+	"sportId": 15,
+	"last": 1725800000,
+	"leagues": [{
+		"id": 889,
+		"events": [{
+			"id": 1596234567,
+			"periods": [{
+				"lineId": 2749812345,
+				"number": 0,
+				"cutoff": "2024-09-08T17:00:00Z",
+				"moneyline": {"home": -150, "away": 130},
+				"spreads": [
+					{"hdp": -3.5, "home": -105, "away": -105},
+					{"altLineId": 1, "hdp": 0, "home": -190, "away": 165}
+				],
+				"totals": [{"points": 45.5, "over": -108, "under": -102}]
+			}, {
+				"lineId": 2749812346,
+				"number": 1,
+				"moneyline": {"home": -140, "away": 120}
+			}]
+		}, {
+			"id": 1596234999,
+			"periods": [{
+				"number": 0,
+				"moneyline": {"home": 2.1, "away": 3.6, "draw": 3.4}
+			}]
+		}]
+	}]
+}`
+
+func TestParseOdds(t *testing.T) {
+	teams := map[int64]Teams{1596234567: {Home: "NE", Away: "NYJ"}}
+	markets, err := ParseOdds(strings.NewReader(sampleFeed), American, teams)
+	assert.NoError(t, err)
+	assert.Len(t, markets, 6)
+
+	ml := markets[0]
+	assert.Equal(t, "NYJ @ NE", ml.Event)
+	assert.Equal(t, "moneyline", ml.Name)
+	assert.Equal(t, Book, ml.Book)
+	assert.Equal(t, "NE", ml.Outcomes[0].Name)
+	assert.Equal(t, -150.0, ml.Outcomes[0].Odds.American())
+	assert.Equal(t, "NYJ", ml.Outcomes[1].Name)
+
+	assert.Equal(t, "spread", markets[1].Name)
+	assert.Equal(t, "NE -3.5", markets[1].Outcomes[0].Name)
+	assert.Equal(t, "NYJ +3.5", markets[1].Outcomes[1].Name)
+	assert.Equal(t, "NE +0", markets[2].Outcomes[0].Name)
+	assert.Equal(t, "NYJ +0", markets[2].Outcomes[1].Name)
+
+	assert.Equal(t, "total", markets[3].Name)
+	assert.Equal(t, "Over 45.5", markets[3].Outcomes[0].Name)
+	assert.Equal(t, -102.0, markets[3].Outcomes[1].Odds.American())
+
+	assert.Equal(t, "moneyline period 1", markets[4].Name)
+
+	// The second event is unnamed, and parsed as american odds is nonsense, so
+	// reparse the feed as decimal to check its three way moneyline.
+	markets, err = ParseOdds(strings.NewReader(sampleFeed), Decimal, nil)
+	assert.NoError(t, err)
+	ml = markets[5]
+	assert.Equal(t, "1596234999", ml.Event)
+	assert.Equal(t, []string{"Home", "Draw", "Away"},
+		[]string{ml.Outcomes[0].Name, ml.Outcomes[1].Name, ml.Outcomes[2].Name})
+	assert.Equal(t, 3.4, ml.Outcomes[1].Odds.Decimal())
+
+	_, err = ParseOdds(strings.NewReader("{"), American, nil)
+	assert.Error(t, err)
+}
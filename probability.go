@@ -0,0 +1,72 @@
+package wagering
+
+import (
+	"fmt"
+)
+
+// NewProbabilityFromFraction constructs a Probability from the fraction num/den,
+// such as 1/4.
+func NewProbabilityFromFraction(num, den float64) Probability {
+	return NewProbabilityFromDecimal(num / den)
+}
+
+// NewProbabilityFromOdds constructs the Probability implied by the given odds. It
+// is equivalent to odds.ImpliedProb.
+func NewProbabilityFromOdds(odds Odds) Probability {
+	return odds.ImpliedProb()
+}
+
+// FairOdds returns the Odds with no vig for the probability, the inverse of
+// NewProbabilityFromOdds.
+func (prob Probability) FairOdds() Odds {
+	return NewOddsFromDecimal(1.0 / prob.decimal)
+}
+
+// Valid returns whether the probability is within [0, 1].
+func (prob Probability) Valid() bool {
+	return prob.decimal >= 0.0 && prob.decimal <= 1.0
+}
+
+// checked returns result, the combination of the given probabilities, or an error
+// if any of them or result is not a valid probability.
+func checked(result Probability, probs ...Probability) (Probability, error) {
+	for _, p := range append(probs, result) {
+		if !p.Valid() {
+			return Probability{}, fmt.Errorf("probability %v is not within [0, 1]", p.decimal)
+		}
+	}
+	return result, nil
+}
+
+// Complement returns the probability of the event not occurring. An error is
+// returned if the probability is not valid.
+func (prob Probability) Complement() (Probability, error) {
+	return checked(NewProbabilityFromDecimal(1.0-prob.decimal), prob)
+}
+
+// And returns the probability of both the event and the independent other event
+// occurring. An error is returned if either probability is not valid.
+func (prob Probability) And(other Probability) (Probability, error) {
+	return checked(NewProbabilityFromDecimal(prob.decimal*other.decimal), prob, other)
+}
+
+// Or returns the probability of either or both of the event and the independent
+// other event occurring. An error is returned if either probability is not valid.
+func (prob Probability) Or(other Probability) (Probability, error) {
+	return checked(NewProbabilityFromDecimal(1.0-(1.0-prob.decimal)*(1.0-other.decimal)), prob, other)
+}
+
+// Given returns the conditional probability of an event A given an event B, where
+// prob is the probability of both A and B occurring and condition the probability
+// of B. An error is returned if condition is zero or the result is not a valid
+// probability, as when prob exceeds condition.
+func (prob Probability) Given(condition Probability) (Probability, error) {
+	if condition.decimal == 0.0 {
+		return Probability{}, fmt.Errorf("conditioning on an event of probability zero")
+	}
+	result := NewProbabilityFromDecimal(prob.decimal / condition.decimal)
+	if !prob.Valid() || !condition.Valid() || !result.Valid() {
+		return Probability{}, fmt.Errorf("joint probability %v and condition %v do not give a valid probability", prob.decimal, condition.decimal)
+	}
+	return result, nil
+}
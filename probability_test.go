@@ -0,0 +1,60 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestProbability_Valid(t *testing.T) {
+	assert.True(t, NewProbabilityFromDecimal(0.0).Valid())
+	assert.True(t, NewProbabilityFromDecimal(1.0).Valid())
+	assert.False(t, NewProbabilityFromDecimal(1.1).Valid())
+	assert.False(t, NewProbabilityFromPercent(-5.0).Valid())
+}
+
+func TestProbability_Combinations(t *testing.T) {
+	a := NewProbabilityFromDecimal(0.6)
+	b := NewProbabilityFromDecimal(0.5)
+	complement, err := a.Complement()
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.4, complement.Decimal(), 1e-12)
+	assert.InDelta(t, 40.0, complement.Percent(), 1e-9)
+	and, err := a.And(b)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.3, and.Decimal(), 1e-12)
+	or, err := a.Or(b)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.8, or.Decimal(), 1e-12)
+
+	invalid := NewProbabilityFromDecimal(1.5)
+	_, err = invalid.Complement()
+	assert.Error(t, err)
+	_, err = a.And(invalid)
+	assert.Error(t, err)
+	_, err = invalid.Or(b)
+	assert.Error(t, err)
+	_, err = a.Or(NewProbabilityFromDecimal(math.NaN()))
+	assert.Error(t, err)
+}
+
+func TestProbability_Given(t *testing.T) {
+	joint := NewProbabilityFromDecimal(0.3)
+	given, err := joint.Given(NewProbabilityFromDecimal(0.5))
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.6, given.Decimal(), 1e-12)
+
+	_, err = joint.Given(NewProbabilityFromDecimal(0.0))
+	assert.Error(t, err)
+	_, err = joint.Given(NewProbabilityFromDecimal(0.2))
+	assert.Error(t, err)
+}
+
+func TestProbabilityConversions(t *testing.T) {
+	assert.Equal(t, 0.25, NewProbabilityFromFraction(1.0, 4.0).Decimal())
+	assert.Equal(t, 0.4, NewProbabilityFromOdds(NewOddsFromDecimal(2.5)).Decimal())
+	assert.Equal(t, 2.5, NewProbabilityFromDecimal(0.4).FairOdds().Decimal())
+
+	odds := NewOddsFromAmerican(-150.0)
+	assert.InDelta(t, odds.Decimal(), NewProbabilityFromOdds(odds).FairOdds().Decimal(), 1e-12)
+}
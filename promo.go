@@ -0,0 +1,109 @@
+package wagering
+
+// FreeBetEV returns the long term expected value of using a stake not returned free
+// bet at odds with the given true probability of winning. The result is given as
+// the fraction of the free bet amount that is expected to be converted to cash.
+func FreeBetEV(odds Odds, trueProb Probability) float64 {
+	return trueProb.decimal * (odds.decimalOdds - 1.0)
+}
+
+// bestMarket returns the index of the market with the highest value of ev, or -1
+// if there are no markets.
+func bestMarket(markets []Leg, ev func(Leg) float64) int {
+	best := -1
+	bestEV := 0.0
+	for i, m := range markets {
+		e := ev(m)
+		if best == -1 || e > bestEV {
+			best, bestEV = i, e
+		}
+	}
+	return best
+}
+
+// BestFreeBet returns the index of the market with the highest free bet EV, or -1
+// if there are no markets.
+func BestFreeBet(markets []Leg) int {
+	return bestMarket(markets, func(m Leg) float64 {
+		return FreeBetEV(m.Odds, m.Prob)
+	})
+}
+
+// FreeBetOddsRange returns the shortest and longest odds of the markets whose free
+// bet EV is within tolerance, as a decimal fraction, of the best free bet EV. This
+// is the range of prices to target when using stake not returned free bets.
+func FreeBetOddsRange(markets []Leg, tolerance float64) (min, max Odds) {
+	best := BestFreeBet(markets)
+	if best == -1 {
+		return min, max
+	}
+	threshold := FreeBetEV(markets[best].Odds, markets[best].Prob) * (1.0 - tolerance)
+	min, max = markets[best].Odds, markets[best].Odds
+	for _, m := range markets {
+		if FreeBetEV(m.Odds, m.Prob) >= threshold {
+			if m.Odds.Shorter(min) {
+				min = m.Odds
+			}
+			if m.Odds.Longer(max) {
+				max = m.Odds
+			}
+		}
+	}
+	return min, max
+}
+
+// RiskFreeBetEV returns the long term expected value of a "risk free" bet at odds
+// with the given true probability of winning, where a losing stake is refunded in
+// a form worth conversion of its face value, for example 1.0 for a cash refund or
+// around 0.7 for a free bet refund. The result is given as the percent increase or
+// decrease (negative) of the wager.
+func RiskFreeBetEV(odds Odds, trueProb Probability, conversion float64) float64 {
+	return trueProb.decimal*(odds.decimalOdds-1.0) - (1.0-trueProb.decimal)*(1.0-conversion)
+}
+
+// BestRiskFreeBet returns the index of the market with the highest risk free bet
+// EV for a refund worth conversion of its face value, or -1 if there are no
+// markets.
+func BestRiskFreeBet(markets []Leg, conversion float64) int {
+	return bestMarket(markets, func(m Leg) float64 {
+		return RiskFreeBetEV(m.Odds, m.Prob, conversion)
+	})
+}
+
+// Boosted returns the odds after a profit boost of boostPercent, for example 25.0
+// for a 25% profit boost.
+func (odds Odds) Boosted(boostPercent float64) Odds {
+	return NewOddsFromDecimal(1.0 + (odds.decimalOdds-1.0)*(1.0+boostPercent/100.0))
+}
+
+// BoostEV returns the boosted odds, the payout including stake on a win, and the
+// long term expected profit of wagering maxStake at baseOdds with a profit boost
+// of boostPercent, given the true probability of winning.
+func BoostEV(baseOdds Odds, boostPercent float64, trueProb Probability, maxStake float64) (boosted Odds, payout, ev float64) {
+	boosted = baseOdds.Boosted(boostPercent)
+	return boosted, maxStake * boosted.decimalOdds, maxStake * boosted.ExpectedValueProb(trueProb)
+}
+
+// BestBoost returns the index of the market with the highest expected value after
+// a profit boost of boostPercent, or -1 if there are no markets.
+func BestBoost(markets []Leg, boostPercent float64) int {
+	return bestMarket(markets, func(m Leg) float64 {
+		return m.Odds.Boosted(boostPercent).ExpectedValueProb(m.Prob)
+	})
+}
+
+// BonusClearingCost returns the expected cost of wagering through the rollover of a
+// deposit bonus, where rollover is the multiple of the bonus that must be wagered,
+// on two way markets with the given theoretical hold, as a decimal, at avgOdds.
+// The margin is assumed to be proportional to the odds, as in MPTOdds, so clearing
+// at longer odds costs more.
+func BonusClearingCost(bonus, rollover, hold float64, avgOdds Odds) float64 {
+	margin := 1.0/(1.0-hold) - 1.0
+	return bonus * rollover * margin * avgOdds.decimalOdds / 2.0
+}
+
+// BonusEV returns the expected net value of a deposit bonus after the expected cost
+// of clearing its rollover. See BonusClearingCost for the parameters.
+func BonusEV(bonus, rollover, hold float64, avgOdds Odds) float64 {
+	return bonus - BonusClearingCost(bonus, rollover, hold, avgOdds)
+}
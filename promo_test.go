@@ -0,0 +1,80 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// freeBetMarkets returns markets each with a 5% margin over their true odds.
+func freeBetMarkets() []Leg {
+	var markets []Leg
+	for _, p := range []float64{0.8, 0.5, 0.3, 0.15, 0.05} {
+		markets = append(markets, Leg{NewOddsFromDecimal(1 / (p * 1.05)), NewProbabilityFromDecimal(p)})
+	}
+	return markets
+}
+
+func TestFreeBetEV(t *testing.T) {
+	assert.InDelta(t, 0.5, FreeBetEV(NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.5)), 1e-9)
+	assert.InDelta(t, 0.72, FreeBetEV(NewOddsFromDecimal(5.0), NewProbabilityFromDecimal(0.18)), 1e-9)
+}
+
+func TestBestFreeBet(t *testing.T) {
+	assert.Equal(t, 4, BestFreeBet(freeBetMarkets()))
+	assert.Equal(t, -1, BestFreeBet(nil))
+}
+
+func TestFreeBetOddsRange(t *testing.T) {
+	markets := freeBetMarkets()
+	min, max := FreeBetOddsRange(markets, 0.15)
+	assert.Equal(t, markets[3].Odds, min)
+	assert.Equal(t, markets[4].Odds, max)
+
+	min, max = FreeBetOddsRange(markets, 0.0)
+	assert.Equal(t, markets[4].Odds, min)
+	assert.Equal(t, markets[4].Odds, max)
+}
+
+func TestRiskFreeBetEV(t *testing.T) {
+	odds := NewOddsFromAmerican(-110.0)
+	prob := NewProbabilityFromPercent(50.0)
+	assert.InDelta(t, odds.ExpectedValueProb(prob), RiskFreeBetEV(odds, prob, 0.0), 1e-9)
+	assert.InDelta(t, 0.4545, RiskFreeBetEV(odds, prob, 1.0), 0.0001)
+	assert.InDelta(t, 0.3045, RiskFreeBetEV(odds, prob, 0.7), 0.0001)
+}
+
+func TestBestRiskFreeBet(t *testing.T) {
+	assert.Equal(t, 4, BestRiskFreeBet(freeBetMarkets(), 0.7))
+	assert.Equal(t, -1, BestRiskFreeBet(nil, 0.7))
+}
+
+func TestOdds_Boosted(t *testing.T) {
+	assert.InDelta(t, 3.5, NewOddsFromDecimal(3.0).Boosted(25.0).decimalOdds, 1e-9)
+	assert.InDelta(t, 250.0, NewOddsFromAmerican(+200.0).Boosted(25.0).americanOdds, 1e-9)
+}
+
+func TestBoostEV(t *testing.T) {
+	boosted, payout, ev := BoostEV(NewOddsFromAmerican(+200.0), 50.0, NewProbabilityFromPercent(30.0), 50.0)
+	assert.InDelta(t, 4.0, boosted.decimalOdds, 1e-9)
+	assert.InDelta(t, 200.0, payout, 1e-9)
+	assert.InDelta(t, 10.0, ev, 1e-9)
+}
+
+func TestBestBoost(t *testing.T) {
+	assert.Equal(t, 4, BestBoost(freeBetMarkets(), 25.0))
+	assert.Equal(t, 0, BestBoost(freeBetMarkets()[:1], 25.0))
+}
+
+func TestBonusClearingCost(t *testing.T) {
+	// At -110 each side of a 4.55% hold market costs the full hold.
+	cost := BonusClearingCost(100.0, 10.0, 0.04545, NewOddsFromAmerican(-110.0))
+	assert.InDelta(t, 45.45, cost, 0.01)
+	// Clearing at longer odds costs more.
+	cost = BonusClearingCost(100.0, 10.0, 0.04545, NewOddsFromAmerican(+200.0))
+	assert.InDelta(t, 71.42, cost, 0.01)
+}
+
+func TestBonusEV(t *testing.T) {
+	assert.InDelta(t, 54.55, BonusEV(100.0, 10.0, 0.04545, NewOddsFromAmerican(-110.0)), 0.01)
+	assert.Less(t, BonusEV(100.0, 25.0, 0.04545, NewOddsFromAmerican(-110.0)), 0.0)
+}
@@ -0,0 +1,129 @@
+package wagering
+
+import (
+	"math"
+)
+
+// PlaceTerms are the terms of the place part of an each way bet, for example a
+// fifth of the odds for the first 3 places.
+type PlaceTerms struct {
+	// Fraction is the fraction of the win odds, as profit, paid for a place, for
+	// example 0.2 for 1/5 odds.
+	Fraction float64
+	// Places is the number of finishing positions paid as a place.
+	Places int
+}
+
+// EachWay is an each way bet, a win bet and a place bet of equal stakes on the same
+// selection.
+type EachWay struct {
+	odds  Odds
+	terms PlaceTerms
+	stake float64
+}
+
+// NewEachWay constructs a new EachWay with stake on each of the win and place parts
+// at the given win odds and place terms.
+func NewEachWay(odds Odds, terms PlaceTerms, stake float64) EachWay {
+	return EachWay{odds: odds, terms: terms, stake: stake}
+}
+
+// placeOdds returns the odds paid for a place at the given win odds.
+func (terms PlaceTerms) placeOdds(odds Odds) Odds {
+	return NewOddsFromDecimal(1.0 + (odds.decimalOdds-1.0)*terms.Fraction)
+}
+
+// PlaceOdds returns the odds paid by the place part of the bet.
+func (ew EachWay) PlaceOdds() Odds {
+	return ew.terms.placeOdds(ew.odds)
+}
+
+// TotalStake returns the total amount wagered, the stake of both parts.
+func (ew EachWay) TotalStake() float64 {
+	return 2.0 * ew.stake
+}
+
+// Payout returns the total return, including stakes, of the bet for the given
+// finishing position, starting at 1 for the winner.
+func (ew EachWay) Payout(finish int) float64 {
+	payout := 0.0
+	if finish == 1 {
+		payout += ew.stake * ew.odds.decimalOdds
+	}
+	if finish >= 1 && finish <= ew.terms.Places {
+		payout += ew.stake * ew.PlaceOdds().decimalOdds
+	}
+	return payout
+}
+
+// ExpectedValue returns the long term expected value of the bet given the
+// probability of the selection winning and of it finishing in the places, which
+// includes winning. The result is given as the percent increase or decrease
+// (negative) of the total stake.
+func (ew EachWay) ExpectedValue(winProb, placeProb Probability) float64 {
+	win := winProb.decimal * ew.odds.decimalOdds
+	place := placeProb.decimal * ew.PlaceOdds().decimalOdds
+	return (win+place)/2.0 - 1.0
+}
+
+// rule4Bands holds the Tattersalls Rule 4(c) deductions, as a decimal of winnings,
+// by the longest decimal odds of the withdrawn selection in each band. Selections
+// withdrawn at longer odds than the last band incur no deduction.
+var rule4Bands = []struct {
+	maxOdds   float64
+	deduction float64
+}{
+	{1.0 + 1.0/9.0, 0.90},
+	{1.0 + 2.0/13.0, 0.85},
+	{1.0 + 1.0/4.0, 0.80},
+	{1.0 + 1.0/3.0, 0.75},
+	{1.0 + 4.0/9.0, 0.70},
+	{1.0 + 8.0/13.0, 0.65},
+	{1.0 + 4.0/5.0, 0.60},
+	{1.0 + 20.0/21.0, 0.55},
+	{1.0 + 6.0/5.0, 0.50},
+	{1.0 + 6.0/4.0, 0.45},
+	{1.0 + 7.0/4.0, 0.40},
+	{1.0 + 9.0/4.0, 0.35},
+	{1.0 + 3.0, 0.30},
+	{1.0 + 4.0, 0.25},
+	{1.0 + 11.0/2.0, 0.20},
+	{1.0 + 9.0, 0.15},
+	{1.0 + 14.0, 0.10},
+}
+
+// maxRule4Deduction is the most that can be deducted for multiple withdrawals.
+const maxRule4Deduction = 0.75
+
+// Rule4Deduction returns the Rule 4 deduction, as a decimal of winnings, for the
+// withdrawal of selections at the given odds. Deductions for multiple withdrawals
+// are summed and capped at 75%.
+// https://en.wikipedia.org/wiki/Rule_4_deduction
+func Rule4Deduction(withdrawn ...Odds) float64 {
+	total := 0.0
+	for _, w := range withdrawn {
+		for _, band := range rule4Bands {
+			if w.decimalOdds <= band.maxOdds+1e-9 {
+				total += band.deduction
+				break
+			}
+		}
+	}
+	if len(withdrawn) > 1 {
+		total = math.Min(total, maxRule4Deduction)
+	}
+	return total
+}
+
+// Rule4 returns the odds after applying a Rule 4 deduction, as a decimal of
+// winnings, to odds.
+func (odds Odds) Rule4(deduction float64) Odds {
+	return NewOddsFromDecimal(1.0 + (odds.decimalOdds-1.0)*(1.0-deduction))
+}
+
+// Rule4 returns the each way bet after applying a Rule 4 deduction, as a decimal of
+// winnings, to its win odds. The place part is paid at the place terms of the
+// reduced win odds.
+func (ew EachWay) Rule4(deduction float64) EachWay {
+	return NewEachWay(ew.odds.Rule4(deduction), ew.terms, ew.stake)
+}
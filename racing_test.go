@@ -0,0 +1,55 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEachWay_Payout(t *testing.T) {
+	ew := NewEachWay(NewOddsFromDecimal(11.0), PlaceTerms{Fraction: 0.2, Places: 3}, 10.0)
+	assert.Equal(t, 20.0, ew.TotalStake())
+	assert.InDelta(t, 3.0, ew.PlaceOdds().decimalOdds, 1e-9)
+	assert.InDelta(t, 140.0, ew.Payout(1), 1e-9)
+	assert.InDelta(t, 30.0, ew.Payout(2), 1e-9)
+	assert.InDelta(t, 30.0, ew.Payout(3), 1e-9)
+	assert.Equal(t, 0.0, ew.Payout(4))
+}
+
+func TestEachWay_ExpectedValue(t *testing.T) {
+	ew := NewEachWay(NewOddsFromDecimal(11.0), PlaceTerms{Fraction: 0.2, Places: 3}, 10.0)
+	// Win part: 0.1 * 11 = 1.1, place part: 0.35 * 3 = 1.05.
+	ev := ew.ExpectedValue(NewProbabilityFromDecimal(0.1), NewProbabilityFromDecimal(0.35))
+	assert.InDelta(t, 0.075, ev, 1e-9)
+}
+
+func TestRule4Deduction(t *testing.T) {
+	var expectedDeductions = []struct {
+		withdrawn Odds
+		deduction float64
+	}{
+		{NewOddsFromDecimal(1.1), 0.90},
+		{NewOddsFromAmerican(-300.0), 0.75},
+		{NewOddsFromDecimal(2.0), 0.50},
+		{NewOddsFromDecimal(2.2), 0.50},
+		{NewOddsFromDecimal(2.25), 0.45},
+		{NewOddsFromDecimal(5.0), 0.25},
+		{NewOddsFromDecimal(15.0), 0.10},
+		{NewOddsFromDecimal(17.0), 0.0},
+	}
+	for _, ed := range expectedDeductions {
+		assert.InDelta(t, ed.deduction, Rule4Deduction(ed.withdrawn), 1e-9, "deduction for %v", ed.withdrawn.decimalOdds)
+	}
+	assert.InDelta(t, 0.35, Rule4Deduction(NewOddsFromDecimal(5.0), NewOddsFromDecimal(11.0)), 1e-9)
+	assert.InDelta(t, 0.75, Rule4Deduction(NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)), 1e-9)
+}
+
+func TestOdds_Rule4(t *testing.T) {
+	assert.InDelta(t, 8.5, NewOddsFromDecimal(11.0).Rule4(0.25).decimalOdds, 1e-9)
+}
+
+func TestEachWay_Rule4(t *testing.T) {
+	ew := NewEachWay(NewOddsFromDecimal(11.0), PlaceTerms{Fraction: 0.2, Places: 3}, 10.0).Rule4(0.25)
+	assert.InDelta(t, 2.5, ew.PlaceOdds().decimalOdds, 1e-9)
+	assert.InDelta(t, 110.0, ew.Payout(1), 1e-9)
+	assert.InDelta(t, 25.0, ew.Payout(3), 1e-9)
+}
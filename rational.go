@@ -0,0 +1,96 @@
+package wagering
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RationalOdds is an exact representation of odds as a rational number, for
+// settlement audits where the rounding skew of chained float conversions is
+// unacceptable. The zero value is not valid; use one of the constructors.
+type RationalOdds struct {
+	decimal *big.Rat
+}
+
+// NewRationalOdds returns the RationalOdds for the fractional odds num/den, the
+// profit won per den wagered, such as 5/2. Odds must pay a profit, so num and den
+// must be positive.
+func NewRationalOdds(num, den int64) (RationalOdds, error) {
+	if num <= 0 || den <= 0 {
+		return RationalOdds{}, fmt.Errorf("fractional odds %d/%d not positive", num, den)
+	}
+	fractional := big.NewRat(num, den)
+	return RationalOdds{decimal: fractional.Add(fractional, big.NewRat(1, 1))}, nil
+}
+
+// NewRationalOddsFromAmerican returns the RationalOdds for the given american odds,
+// which must be at least 100 or at most -100.
+func NewRationalOddsFromAmerican(americanOdds int64) (RationalOdds, error) {
+	if americanOdds > -100 && americanOdds < 100 {
+		return RationalOdds{}, fmt.Errorf("invalid american odds %d", americanOdds)
+	}
+	if americanOdds < 0 {
+		return NewRationalOdds(100, -americanOdds)
+	}
+	return NewRationalOdds(americanOdds, 100)
+}
+
+// ParseRationalOdds parses fractional odds, such as "5/2" or "11/10", or decimal
+// odds, such as "2.5", exactly. Odds must pay a profit, so fractional odds must be
+// positive and decimal odds greater than 1.
+func ParseRationalOdds(s string) (RationalOdds, error) {
+	// SetString rejects a zero denominator.
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return RationalOdds{}, fmt.Errorf("invalid rational odds %q", s)
+	}
+	if strings.ContainsRune(s, '/') {
+		if r.Sign() <= 0 {
+			return RationalOdds{}, fmt.Errorf("fractional odds %q not positive", s)
+		}
+		return RationalOdds{decimal: r.Add(r, big.NewRat(1, 1))}, nil
+	}
+	if r.Cmp(big.NewRat(1, 1)) <= 0 {
+		return RationalOdds{}, fmt.Errorf("decimal odds %q not greater than 1", s)
+	}
+	return RationalOdds{decimal: r}, nil
+}
+
+// Decimal returns the decimal odds, the amount returned including the stake per
+// unit wagered.
+func (ro RationalOdds) Decimal() *big.Rat {
+	return new(big.Rat).Set(ro.decimal)
+}
+
+// Fractional returns the fractional odds, the profit per unit wagered.
+func (ro RationalOdds) Fractional() *big.Rat {
+	r := ro.Decimal()
+	return r.Sub(r, big.NewRat(1, 1))
+}
+
+// String returns the fractional odds in lowest terms, such as "5/2".
+func (ro RationalOdds) String() string {
+	return ro.Fractional().String()
+}
+
+// Odds returns the nearest float Odds.
+func (ro RationalOdds) Odds() Odds {
+	f, _ := ro.decimal.Float64()
+	return NewOddsFromDecimal(f)
+}
+
+// Payout returns the exact total returned, including the stake, of a winning
+// wager of stake.
+func (ro RationalOdds) Payout(stake *big.Rat) *big.Rat {
+	return new(big.Rat).Mul(stake, ro.decimal)
+}
+
+// RationalParlayOdds returns the exact combined odds of a parlay of the given odds.
+func RationalParlayOdds(odds ...RationalOdds) RationalOdds {
+	decimal := big.NewRat(1, 1)
+	for _, o := range odds {
+		decimal.Mul(decimal, o.decimal)
+	}
+	return RationalOdds{decimal: decimal}
+}
@@ -0,0 +1,57 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestRationalOdds(t *testing.T) {
+	odds, err := NewRationalOdds(10, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "5/2", odds.String())
+	assert.Equal(t, big.NewRat(7, 2), odds.Decimal())
+	assert.Equal(t, 3.5, odds.Odds().Decimal())
+	for _, fractional := range [][2]int64{{5, 0}, {0, 1}, {-5, 2}, {5, -2}} {
+		_, err = NewRationalOdds(fractional[0], fractional[1])
+		assert.Error(t, err, fractional)
+	}
+
+	odds, err = NewRationalOddsFromAmerican(-110)
+	assert.NoError(t, err)
+	assert.Equal(t, "10/11", odds.String())
+	odds, err = NewRationalOddsFromAmerican(150)
+	assert.NoError(t, err)
+	assert.Equal(t, "3/2", odds.String())
+	odds, err = NewRationalOddsFromAmerican(100)
+	assert.NoError(t, err)
+	assert.Equal(t, "1/1", odds.String())
+	for _, american := range []int64{0, 50, -99} {
+		_, err = NewRationalOddsFromAmerican(american)
+		assert.Error(t, err, american)
+	}
+}
+
+func TestParseRationalOdds(t *testing.T) {
+	odds, err := ParseRationalOdds("11/10")
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(21, 10), odds.Decimal())
+
+	odds, err = ParseRationalOdds("1.91")
+	assert.NoError(t, err)
+	assert.Equal(t, "91/100", odds.String())
+
+	for _, s := range []string{"0.5", "1", "-5/2", "0/1", "5/0", "evens"} {
+		_, err = ParseRationalOdds(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestRationalParlayOdds(t *testing.T) {
+	leg, err := NewRationalOddsFromAmerican(-110)
+	assert.NoError(t, err)
+	parlay := RationalParlayOdds(leg, leg, leg)
+	// (21/11)^3 exactly, where the float decimal odds 1.9090... would drift.
+	assert.Equal(t, big.NewRat(9261, 1331), parlay.Decimal())
+	assert.Equal(t, big.NewRat(92610, 1331), parlay.Payout(big.NewRat(10, 1)))
+}
@@ -0,0 +1,67 @@
+package wagering
+
+import (
+	"fmt"
+)
+
+// Result is the graded result of a wager or of a single leg of a wager.
+type Result int
+
+const (
+	Loss Result = iota
+	Win
+	// Push is a wager that is neither won nor lost and returns the stake. Void
+	// selections are settled as a Push.
+	Push
+	// HalfWin is a wager where half the stake wins and half is returned, as on
+	// some Asian handicap lines.
+	HalfWin
+	// HalfLoss is a wager where half the stake loses and half is returned, as on
+	// some Asian handicap lines.
+	HalfLoss
+)
+
+// String returns the name of the result.
+func (r Result) String() string {
+	switch r {
+	case Loss:
+		return "loss"
+	case Win:
+		return "win"
+	case Push:
+		return "push"
+	case HalfWin:
+		return "half win"
+	case HalfLoss:
+		return "half loss"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseResult returns the Result with the given name, as returned by String.
+func ParseResult(s string) (Result, error) {
+	for _, r := range []Result{Loss, Win, Push, HalfWin, HalfLoss} {
+		if s == r.String() {
+			return r, nil
+		}
+	}
+	return Loss, fmt.Errorf("unknown result %q", s)
+}
+
+// settledOdds returns the decimal odds a leg at the given odds settles at for the
+// given result.
+func settledOdds(odds Odds, result Result) float64 {
+	switch result {
+	case Win:
+		return odds.decimalOdds
+	case Push:
+		return 1.0
+	case HalfWin:
+		return (odds.decimalOdds + 1.0) / 2.0
+	case HalfLoss:
+		return 0.5
+	default:
+		return 0.0
+	}
+}
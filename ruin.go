@@ -0,0 +1,107 @@
+package wagering
+
+import (
+	"math"
+)
+
+// probFromEdge returns the probability of winning a bet at odds with the given
+// edge, the expected value as a decimal of the stake.
+func probFromEdge(edge float64, odds Odds) Probability {
+	return NewProbabilityFromDecimal((1.0 + edge) / odds.decimalOdds)
+}
+
+// RiskOfRuin returns the probability of the bankroll ever falling by
+// targetDrawdown, as a decimal such as 0.5 for half, when repeatedly staking the
+// fraction of the current bankroll on bets at odds with the given edge, the
+// expected value as a decimal of the stake. It uses the diffusion approximation of
+// the log of the bankroll, under which the probability is (1 - targetDrawdown)
+// raised to 2 * mu / sigma^2 for a drift of mu and variance of sigma^2 per bet.
+// A bettor with no growth is certain to be ruined.
+func RiskOfRuin(edge float64, odds Odds, fraction, targetDrawdown float64) Probability {
+	p := probFromEdge(edge, odds).decimal
+	mu := GrowthRate(odds, NewProbabilityFromDecimal(p), fraction)
+	if mu <= 0.0 {
+		return NewProbabilityFromDecimal(1.0)
+	}
+	volatility := GrowthVolatility(odds, NewProbabilityFromDecimal(p), fraction)
+	return NewProbabilityFromDecimal(math.Pow(1.0-targetDrawdown, 2.0*mu/(volatility*volatility)))
+}
+
+// SimulatedRiskOfRuin returns the probability of the bankroll falling by
+// targetDrawdown within the given number of bets, as in RiskOfRuin, estimated by
+// simulating trials sequences of bets seeded with seed. Unlike RiskOfRuin it is
+// exact, up to sampling error, for a finite horizon.
+func SimulatedRiskOfRuin(edge float64, odds Odds, fraction, targetDrawdown float64, bets, trials int, seed int64) Probability {
+	return simulateFraction(edge, odds, fraction, 1.0-targetDrawdown, bets, trials, seed).BustProb()
+}
+
+// simulateFraction simulates trials sequences of bets staking fraction of the
+// current bankroll on bets at odds with the given edge.
+func simulateFraction(edge float64, odds Odds, fraction, bustFraction float64, bets, trials int, seed int64) SimulationResult {
+	leg := Leg{odds, probFromEdge(edge, odds)}
+	opportunities := make([]Leg, bets)
+	for i := range opportunities {
+		opportunities[i] = leg
+	}
+	config := SimulationConfig{Bankroll: 1.0, Seasons: trials, BustFraction: bustFraction, Seed: seed}
+	return Simulate(config, opportunities, PercentStake{Percent: fraction * 100.0})
+}
+
+// MaxDrawdownQuantiles returns the quantiles, at the given levels from 0 to 1, of
+// the largest peak to trough fall in bankroll, as a decimal of the peak, over the
+// given number of bets when staking the fraction of the current bankroll on bets at
+// odds with the given edge. The distribution is estimated by simulating trials
+// sequences of bets seeded with seed.
+func MaxDrawdownQuantiles(edge float64, odds Odds, fraction float64, bets, trials int, seed int64, levels ...float64) []float64 {
+	result := simulateFraction(edge, odds, fraction, 0.0, bets, trials, seed)
+	var quantiles []float64
+	for _, level := range levels {
+		quantiles = append(quantiles, result.MaxDrawdown(level))
+	}
+	return quantiles
+}
+
+// LongestLosingStreak returns the expected length of the longest run of losses over
+// n independent bets that each win with prob, and the length at each of the given
+// quantile levels, 0 to 1, the shortest length that run is no longer than with at
+// least that probability. The distribution is computed exactly.
+func LongestLosingStreak(prob Probability, n int, levels ...float64) (expected float64, quantiles []int) {
+	p := prob.decimal
+	q := 1.0 - p
+	// cdf[k] is the probability that the longest losing run is no longer than k.
+	cdf := make([]float64, n+1)
+	for k := 0; k <= n; k++ {
+		// a[i] is the probability of no run longer than k in i bets, using the
+		// recurrence a[i] = a[i-1] - p * q^(k+1) * a[i-k-2].
+		a := make([]float64, n+1)
+		qk := math.Pow(q, float64(k+1))
+		for i := 0; i <= n; i++ {
+			switch {
+			case i <= k:
+				a[i] = 1.0
+			case i == k+1:
+				a[i] = 1.0 - qk
+			default:
+				a[i] = a[i-1] - p*qk*a[i-k-2]
+			}
+		}
+		cdf[k] = a[n]
+		if 1.0-cdf[k] < 1e-15 {
+			for j := k + 1; j <= n; j++ {
+				cdf[j] = 1.0
+			}
+			break
+		}
+	}
+	for k := 0; k < n; k++ {
+		expected += 1.0 - cdf[k]
+	}
+	for _, level := range levels {
+		k := 0
+		for k < n && cdf[k] < level {
+			k++
+		}
+		quantiles = append(quantiles, k)
+	}
+	return expected, quantiles
+}
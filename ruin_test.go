@@ -0,0 +1,53 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRiskOfRuin(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	// Full Kelly at a 10% edge halves the bankroll about half of the time.
+	assert.InDelta(t, 0.5, RiskOfRuin(0.1, odds, 0.1, 0.5).decimal, 0.02)
+	// Half Kelly, about an eighth of the time.
+	assert.InDelta(t, 0.125, RiskOfRuin(0.1, odds, 0.05, 0.5).decimal, 0.02)
+	// Over betting with no growth is certain ruin.
+	assert.Equal(t, 1.0, RiskOfRuin(0.1, odds, 0.2, 0.5).decimal)
+	assert.Equal(t, 1.0, RiskOfRuin(-0.05, odds, 0.01, 0.5).decimal)
+}
+
+func TestSimulatedRiskOfRuin(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	simulated := SimulatedRiskOfRuin(0.1, odds, 0.1, 0.5, 5000, 1000, 1)
+	assert.InDelta(t, RiskOfRuin(0.1, odds, 0.1, 0.5).decimal, simulated.decimal, 0.06)
+	// A short horizon has less time to be ruined.
+	assert.Less(t, SimulatedRiskOfRuin(0.1, odds, 0.1, 0.5, 50, 1000, 1).decimal, simulated.decimal)
+}
+
+func TestMaxDrawdownQuantiles(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	quantiles := MaxDrawdownQuantiles(0.1, odds, 0.1, 1000, 1000, 1, 0.5, 0.9)
+	assert.Len(t, quantiles, 2)
+	assert.Less(t, quantiles[0], quantiles[1])
+	// Drawdowns are measured from every new peak so exceed the ruin from the start.
+	assert.Greater(t, quantiles[0], 0.5)
+
+	halfKelly := MaxDrawdownQuantiles(0.1, odds, 0.05, 1000, 1000, 1, 0.5)
+	assert.Less(t, halfKelly[0], quantiles[0])
+}
+
+func TestLongestLosingStreak(t *testing.T) {
+	// Two fair bets: runs of 0, 1, 1, 2 equally likely.
+	expected, quantiles := LongestLosingStreak(NewProbabilityFromDecimal(0.5), 2, 0.25, 0.5, 0.75, 1.0)
+	assert.InDelta(t, 1.0, expected, 1e-9)
+	assert.Equal(t, []int{0, 1, 1, 2}, quantiles)
+
+	// Three fair bets, the longest runs of the 8 sequences sum to 11.
+	expected, _ = LongestLosingStreak(NewProbabilityFromDecimal(0.5), 3)
+	assert.InDelta(t, 11.0/8.0, expected, 1e-9)
+
+	// About log2(n) for near fair bets.
+	expected, quantiles = LongestLosingStreak(NewProbabilityFromPercent(52.4), 1000, 0.5, 0.95)
+	assert.InDelta(t, 8.71, expected, 0.01)
+	assert.Equal(t, []int{8, 12}, quantiles)
+}
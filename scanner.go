@@ -0,0 +1,81 @@
+package wagering
+
+import (
+	"sort"
+)
+
+// Play is a quoted price with positive expected value found by a Scanner.
+type Play struct {
+	Event   string
+	Market  string
+	Book    string
+	Outcome string
+	Odds    Odds
+	// Fair is the fair probability of the outcome.
+	Fair Probability
+	// EV is the expected value of wagering at Odds, as the percent increase or
+	// decrease (negative) of the wager.
+	EV float64
+	// KellyFraction is the recommended fraction of the bankroll to wager.
+	KellyFraction float64
+	// Stake is the recommended amount to wager.
+	Stake float64
+}
+
+// Scanner scans quoted markets for prices with positive expected value against a
+// fair market.
+type Scanner struct {
+	// MinEV is the expected value, as a decimal such as 0.02 for 2%, a price must
+	// exceed to be a play.
+	MinEV float64
+	// KellyMultiplier scales the Kelly stakes recommended for plays.
+	KellyMultiplier float64
+	// Bankroll is the bankroll Kelly stakes are computed from.
+	Bankroll float64
+	// Filters, if any, must all select a play for it to be returned.
+	Filters []Filter
+}
+
+// Scan returns the plays among the quoted markets, those outcomes whose expected
+// value against the fair odds of the same outcome of the fair market exceeds MinEV
+// and that are selected by the Filters, sorted by expected value from highest.
+// Quoted markets for other events or markets, and outcomes missing from the fair
+// market, are ignored.
+func (s Scanner) Scan(fair Market, quotes ...Market) []Play {
+	var plays []Play
+	for _, q := range quotes {
+		if !q.sameMarket(fair) {
+			continue
+		}
+		for _, o := range q.Outcomes {
+			f, ok := fair.Outcome(o.Name)
+			if !ok {
+				continue
+			}
+			prob := f.Odds.ImpliedProb()
+			ev := o.Odds.ExpectedValueProb(prob)
+			if ev <= s.MinEV {
+				continue
+			}
+			fraction := o.Odds.KellyFraction(prob, s.KellyMultiplier)
+			play := Play{
+				Event:         q.Event,
+				Market:        q.Name,
+				Book:          q.Book,
+				Outcome:       o.Name,
+				Odds:          o.Odds,
+				Fair:          prob,
+				EV:            ev,
+				KellyFraction: fraction,
+				Stake:         fraction * s.Bankroll,
+			}
+			if All(s.Filters...)(play) {
+				plays = append(plays, play)
+			}
+		}
+	}
+	sort.SliceStable(plays, func(i, j int) bool {
+		return plays[i].EV > plays[j].EV
+	})
+	return plays
+}
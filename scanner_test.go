@@ -0,0 +1,30 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestScanner_Scan(t *testing.T) {
+	fair := sampleMarket("pinnacle", -150.0, +130.0).Devig(Multiplicative)
+	other := sampleMarket("bookA", -110.0, +160.0)
+	other.Event = "MIA @ BUF"
+	scanner := Scanner{MinEV: 0.01, KellyMultiplier: 0.5, Bankroll: 1000.0}
+	plays := scanner.Scan(fair,
+		sampleMarket("bookA", -140.0, +150.0),
+		sampleMarket("bookB", -160.0, +145.0),
+		sampleMarket("bookC", -135.0, +115.0),
+		other,
+	)
+	assert.Len(t, plays, 2)
+	assert.Equal(t, "bookA", plays[0].Book)
+	assert.Equal(t, "NYJ", plays[0].Outcome)
+	assert.Equal(t, "bookB", plays[1].Book)
+	assert.Greater(t, plays[0].EV, plays[1].EV)
+	// 2.5 * 0.4202 - 1.
+	assert.InDelta(t, 0.0504, plays[0].EV, 0.0001)
+	assert.InDelta(t, plays[0].Odds.KellyFraction(plays[0].Fair, 0.5), plays[0].KellyFraction, 1e-12)
+	assert.InDelta(t, plays[0].KellyFraction*1000.0, plays[0].Stake, 1e-9)
+
+	assert.Empty(t, Scanner{MinEV: 0.1}.Scan(fair, sampleMarket("bookA", -140.0, +150.0)))
+}
@@ -0,0 +1,140 @@
+package wagering
+
+import (
+	"iter"
+	"sort"
+	"time"
+)
+
+// OddsPoint is the odds quoted at a point in time.
+type OddsPoint struct {
+	Time time.Time
+	Odds Odds
+}
+
+// OddsSeries is the history of the odds of a selection, ordered by time.
+type OddsSeries struct {
+	points []OddsPoint
+}
+
+// NewOddsSeries constructs an OddsSeries of the given points in any order.
+func NewOddsSeries(points ...OddsPoint) OddsSeries {
+	var s OddsSeries
+	for _, p := range points {
+		s.Add(p.Time, p.Odds)
+	}
+	return s
+}
+
+// Add adds the odds quoted at time t to the series.
+func (s *OddsSeries) Add(t time.Time, odds Odds) {
+	i := sort.Search(len(s.points), func(i int) bool {
+		return s.points[i].Time.After(t)
+	})
+	s.points = append(s.points, OddsPoint{})
+	copy(s.points[i+1:], s.points[i:])
+	s.points[i] = OddsPoint{Time: t, Odds: odds}
+}
+
+// Points returns the points of the series ordered by time.
+func (s *OddsSeries) Points() []OddsPoint {
+	return s.points
+}
+
+// All returns an iterator over the time and odds of each point of the series ordered
+// by time.
+func (s *OddsSeries) All() iter.Seq2[time.Time, Odds] {
+	return func(yield func(time.Time, Odds) bool) {
+		for _, p := range s.points {
+			if !yield(p.Time, p.Odds) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the odds of each point of the series ordered by
+// time.
+func (s *OddsSeries) Values() iter.Seq[Odds] {
+	return func(yield func(Odds) bool) {
+		for _, p := range s.points {
+			if !yield(p.Odds) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of points in the series.
+func (s *OddsSeries) Len() int {
+	return len(s.points)
+}
+
+// Open returns the first odds of the series, or the zero Odds if empty.
+func (s *OddsSeries) Open() Odds {
+	if len(s.points) == 0 {
+		return Odds{}
+	}
+	return s.points[0].Odds
+}
+
+// Close returns the last odds of the series, or the zero Odds if empty.
+func (s *OddsSeries) Close() Odds {
+	if len(s.points) == 0 {
+		return Odds{}
+	}
+	return s.points[len(s.points)-1].Odds
+}
+
+// High returns the longest odds of the series, or the zero Odds if empty.
+func (s *OddsSeries) High() Odds {
+	var high Odds
+	for i, p := range s.points {
+		if i == 0 || p.Odds.Longer(high) {
+			high = p.Odds
+		}
+	}
+	return high
+}
+
+// Low returns the shortest odds of the series, or the zero Odds if empty.
+func (s *OddsSeries) Low() Odds {
+	var low Odds
+	for i, p := range s.points {
+		if i == 0 || p.Odds.Shorter(low) {
+			low = p.Odds
+		}
+	}
+	return low
+}
+
+// Movement returns the movement of the odds from open to close in cents on the
+// continuous american scale, positive when the odds lengthened.
+func (s *OddsSeries) Movement() float64 {
+	if len(s.points) == 0 {
+		return 0.0
+	}
+	return americanScale(s.Close()) - americanScale(s.Open())
+}
+
+// ProbMovement returns the movement of the implied probability, as a decimal, from
+// open to close, positive when the odds shortened.
+func (s *OddsSeries) ProbMovement() float64 {
+	if len(s.points) == 0 {
+		return 0.0
+	}
+	return s.Close().ImpliedProb().decimal - s.Open().ImpliedProb().decimal
+}
+
+// Velocity returns the Movement of the series per hour between its first and last
+// points, or zero if they are at the same time.
+func (s *OddsSeries) Velocity() float64 {
+	if len(s.points) < 2 {
+		return 0.0
+	}
+	hours := s.points[len(s.points)-1].Time.Sub(s.points[0].Time).Hours()
+	if hours == 0.0 {
+		return 0.0
+	}
+	return s.Movement() / hours
+}
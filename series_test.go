@@ -0,0 +1,52 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+	"time"
+)
+
+func sampleSeries() OddsSeries {
+	start := time.Date(2024, 9, 8, 9, 0, 0, 0, time.UTC)
+	return NewOddsSeries(
+		OddsPoint{start.Add(4 * time.Hour), NewOddsFromAmerican(-125.0)},
+		OddsPoint{start, NewOddsFromAmerican(-110.0)},
+		OddsPoint{start.Add(2 * time.Hour), NewOddsFromAmerican(+105.0)},
+		OddsPoint{start.Add(3 * time.Hour), NewOddsFromAmerican(-130.0)},
+	)
+}
+
+func TestOddsSeries(t *testing.T) {
+	series := sampleSeries()
+	assert.Equal(t, 4, series.Len())
+	assert.Equal(t, -110.0, series.Open().American())
+	assert.Equal(t, -125.0, series.Close().American())
+	assert.Equal(t, +105.0, series.High().American())
+	assert.Equal(t, -130.0, series.Low().American())
+	assert.True(t, series.Points()[1].Time.Before(series.Points()[2].Time))
+
+	var empty OddsSeries
+	assert.Equal(t, Odds{}, empty.Open())
+	assert.Equal(t, Odds{}, empty.High())
+	assert.Equal(t, 0.0, empty.Movement())
+	assert.Equal(t, 0.0, empty.Velocity())
+}
+
+func TestOddsSeries_Movement(t *testing.T) {
+	series := sampleSeries()
+	assert.InDelta(t, -15.0, series.Movement(), 1e-9)
+	assert.InDelta(t, 0.0318, series.ProbMovement(), 0.0001)
+	assert.InDelta(t, -3.75, series.Velocity(), 1e-9)
+}
+
+func TestOddsSeries_All(t *testing.T) {
+	series := sampleSeries()
+	var times []time.Time
+	for ts := range series.All() {
+		times = append(times, ts)
+	}
+	assert.Len(t, times, 4)
+	assert.True(t, slices.IsSortedFunc(times, time.Time.Compare))
+	assert.Equal(t, NewOddsFromAmerican(+105.0), slices.MaxFunc(slices.Collect(series.Values()), Compare))
+}
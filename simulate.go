@@ -0,0 +1,78 @@
+package wagering
+
+import (
+	"math"
+	"math/rand"
+)
+
+// SimulationConfig configures a Monte Carlo bankroll simulation.
+type SimulationConfig struct {
+	// Bankroll is the starting bankroll of each season.
+	Bankroll float64
+	// Seasons is the number of seasons to simulate.
+	Seasons int
+	// BustFraction is the fraction of the starting bankroll at or below which a
+	// season is considered bust and ends.
+	BustFraction float64
+	// Seed seeds the simulation so that its result is deterministic.
+	Seed int64
+}
+
+// SimulationResult holds the outcome of each simulated season.
+type SimulationResult struct {
+	// FinalBankrolls holds the bankroll at the end of each season, sorted.
+	FinalBankrolls []float64
+	// MaxDrawdowns holds the largest peak to trough fall in bankroll, as a decimal
+	// of the peak, of each season, sorted.
+	MaxDrawdowns []float64
+	// Busts is the number of seasons that went bust.
+	Busts int
+}
+
+// BustProb returns the probability of a season going bust.
+func (sr SimulationResult) BustProb() Probability {
+	return NewProbabilityFromDecimal(float64(sr.Busts) / float64(len(sr.FinalBankrolls)))
+}
+
+// FinalBankroll returns the q quantile, 0 to 1, of the final bankrolls.
+func (sr SimulationResult) FinalBankroll(q float64) float64 {
+	return quantile(sr.FinalBankrolls, q)
+}
+
+// MaxDrawdown returns the q quantile, 0 to 1, of the maximum drawdowns.
+func (sr SimulationResult) MaxDrawdown(q float64) float64 {
+	return quantile(sr.MaxDrawdowns, q)
+}
+
+// Simulate runs a Monte Carlo simulation of seasons wagering, in order, on each of
+// the opportunities, with each leg winning with its probability, staking by plan.
+// Stakes are limited to the current bankroll.
+func Simulate(config SimulationConfig, opportunities []Leg, plan StakingPlan) SimulationResult {
+	rng := rand.New(rand.NewSource(config.Seed))
+	bust := config.Bankroll * config.BustFraction
+	var result SimulationResult
+	for i := 0; i < config.Seasons; i++ {
+		bankroll := config.Bankroll
+		peak := bankroll
+		drawdown := 0.0
+		for _, o := range opportunities {
+			s := math.Min(math.Max(plan.Stake(o.Odds, o.Prob, bankroll), 0.0), bankroll)
+			if rng.Float64() < o.Prob.decimal {
+				bankroll += s * (o.Odds.decimalOdds - 1.0)
+			} else {
+				bankroll -= s
+			}
+			peak = math.Max(peak, bankroll)
+			drawdown = math.Max(drawdown, (peak-bankroll)/peak)
+			if bankroll <= bust {
+				result.Busts++
+				break
+			}
+		}
+		result.FinalBankrolls = append(result.FinalBankrolls, bankroll)
+		result.MaxDrawdowns = append(result.MaxDrawdowns, drawdown)
+	}
+	result.FinalBankrolls = sorted(result.FinalBankrolls)
+	result.MaxDrawdowns = sorted(result.MaxDrawdowns)
+	return result
+}
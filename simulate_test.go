@@ -0,0 +1,30 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func simulationOpportunities(n int) []Leg {
+	var opportunities []Leg
+	for i := 0; i < n; i++ {
+		opportunities = append(opportunities, Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.55)})
+	}
+	return opportunities
+}
+
+func TestSimulate(t *testing.T) {
+	config := SimulationConfig{Bankroll: 1000.0, Seasons: 1000, BustFraction: 0.1, Seed: 1}
+	result := Simulate(config, simulationOpportunities(500), KellyStake{Multiplier: 1.0})
+	assert.Len(t, result.FinalBankrolls, 1000)
+	assert.Len(t, result.MaxDrawdowns, 1000)
+	// Full Kelly grows at about 0.5% a bet: exp(500 * 0.005) is about 12.
+	assert.InDelta(t, 12.0, result.FinalBankroll(0.5)/1000.0, 4.0)
+	assert.Greater(t, result.MaxDrawdown(0.5), 0.3)
+	assert.LessOrEqual(t, result.FinalBankroll(0.0), result.FinalBankroll(1.0))
+
+	flat := FlatStake{Amount: 200.0}
+	result = Simulate(config, simulationOpportunities(500), flat)
+	assert.Greater(t, result.BustProb().decimal, 0.1)
+	assert.Equal(t, result, Simulate(config, simulationOpportunities(500), flat))
+}
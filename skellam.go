@@ -0,0 +1,98 @@
+package wagering
+
+import (
+	"math"
+)
+
+// poissonPMF returns the probability of k events from a Poisson distribution with
+// mean lambda.
+func poissonPMF(k int, lambda float64) float64 {
+	if k < 0 {
+		return 0.0
+	}
+	if lambda == 0.0 {
+		if k == 0 {
+			return 1.0
+		}
+		return 0.0
+	}
+	lgamma, _ := math.Lgamma(float64(k) + 1.0)
+	return math.Exp(float64(k)*math.Log(lambda) - lambda - lgamma)
+}
+
+// Skellam is the distribution of the difference of two independent Poisson counts,
+// such as the final margin of a low scoring sport where each side scores at its own
+// rate. It is a MarginDistribution of the margin about its mean.
+// https://en.wikipedia.org/wiki/Skellam_distribution
+type Skellam struct {
+	mu1 float64
+	mu2 float64
+}
+
+// NewSkellam returns the Skellam distribution of the margin of a side expected to
+// score mu1 over an opponent expected to score mu2.
+func NewSkellam(mu1, mu2 float64) Skellam {
+	return Skellam{mu1: mu1, mu2: mu2}
+}
+
+// Mean returns the expected margin.
+func (s Skellam) Mean() float64 {
+	return s.mu1 - s.mu2
+}
+
+// bound returns a margin beyond which, in either direction, the probability is
+// negligible.
+func (s Skellam) bound() int {
+	total := s.mu1 + s.mu2
+	return int(math.Ceil(total+20.0*math.Sqrt(total))) + 20
+}
+
+// PMF returns the probability that the margin is exactly k.
+func (s Skellam) PMF(k int) float64 {
+	prob := 0.0
+	start := 0
+	if k < 0 {
+		start = -k
+	}
+	for n := start; n <= start+s.bound(); n++ {
+		prob += poissonPMF(n+k, s.mu1) * poissonPMF(n, s.mu2)
+	}
+	return prob
+}
+
+// Prob returns the probability that the margin is exactly k.
+func (s Skellam) Prob(k int) Probability {
+	return NewProbabilityFromDecimal(s.PMF(k))
+}
+
+// marginCDF returns the probability that the margin is at most k.
+func (s Skellam) marginCDF(k int) float64 {
+	prob := 0.0
+	for m := -s.bound(); m <= k; m++ {
+		prob += s.PMF(m)
+	}
+	return math.Min(prob, 1.0)
+}
+
+// OverProb returns the probability that the margin exceeds line, such as 1.5 for a
+// side covering a -1.5 puck line.
+func (s Skellam) OverProb(line float64) Probability {
+	return NewProbabilityFromDecimal(1.0 - s.marginCDF(int(math.Floor(line))))
+}
+
+// CDF returns the probability that the margin less its mean is at most x.
+func (s Skellam) CDF(x float64) float64 {
+	return s.marginCDF(int(math.Floor(s.Mean() + x)))
+}
+
+// Quantile returns the smallest margin less its mean for which CDF is at least p.
+func (s Skellam) Quantile(p float64) float64 {
+	prob := 0.0
+	for m := -s.bound(); m < s.bound(); m++ {
+		prob += s.PMF(m)
+		if prob >= p {
+			return float64(m) - s.Mean()
+		}
+	}
+	return float64(s.bound()) - s.Mean()
+}
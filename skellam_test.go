@@ -0,0 +1,37 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSkellam(t *testing.T) {
+	s := NewSkellam(3.2, 2.8)
+	assert.InDelta(t, 0.4, s.Mean(), 1e-12)
+
+	sum := 0.0
+	for k := -30; k <= 30; k++ {
+		sum += s.PMF(k)
+	}
+	assert.InDelta(t, 1.0, sum, 1e-12)
+
+	// Equal rates are symmetric with a tie probability of e^-2mu I0(2mu).
+	even := NewSkellam(1.0, 1.0)
+	assert.InDelta(t, 0.3085, even.Prob(0).Decimal(), 0.0001)
+	assert.InDelta(t, even.PMF(2), even.PMF(-2), 1e-15)
+	assert.InDelta(t, (1.0-even.PMF(0))/2.0, even.OverProb(0.5).Decimal(), 1e-12)
+}
+
+func TestSkellam_MarginDistribution(t *testing.T) {
+	s := NewSkellam(3.2, 2.8)
+	// The distribution is about the expected margin, the negative of the spread.
+	assert.InDelta(t, s.OverProb(0.0).Decimal(), SpreadWinProb(-s.Mean(), s).Decimal(), 1e-12)
+	// Covering -1.5 is winning by two or more.
+	alts := AltSpreads(-0.4, s, -1.5, 1.5)
+	assert.InDelta(t, s.OverProb(1.5).Decimal(), alts[0].Prob.Decimal(), 1e-12)
+	assert.InDelta(t, s.OverProb(-1.5).Decimal(), alts[1].Prob.Decimal(), 1e-12)
+
+	q := s.Quantile(0.5)
+	assert.GreaterOrEqual(t, s.CDF(q), 0.5)
+	assert.Less(t, s.CDF(q-1.0), 0.5)
+}
@@ -0,0 +1,19 @@
+package wagering
+
+// DrawNoBet returns the fair odds of the draw no bet markets on the home and away
+// sides, where the stake is returned on a draw, from the fair probabilities of a
+// devigged home, draw, away (1X2) market.
+func DrawNoBet(home, draw, away Probability) (homeOdds, awayOdds Odds) {
+	// A draw no bet at decimal odds d is fair when p*d + pDraw = 1.
+	returned := 1.0 - draw.decimal
+	return NewOddsFromDecimal(returned / home.decimal), NewOddsFromDecimal(returned / away.decimal)
+}
+
+// DoubleChance returns the fair odds of the double chance markets, each winning on
+// either of two of the outcomes, from the fair probabilities of a devigged home,
+// draw, away (1X2) market.
+func DoubleChance(home, draw, away Probability) (homeOrDraw, homeOrAway, drawOrAway Odds) {
+	return NewOddsFromDecimal(1.0 / (home.decimal + draw.decimal)),
+		NewOddsFromDecimal(1.0 / (home.decimal + away.decimal)),
+		NewOddsFromDecimal(1.0 / (draw.decimal + away.decimal))
+}
@@ -0,0 +1,184 @@
+// Package soccer prices soccer markets from a model of the goals scored by each
+// side, the generative counterpart to devigging a book's prices.
+package soccer
+
+import (
+	"math"
+
+	"github.com/dburger/wagering"
+)
+
+// maxGoals is the most goals by a side considered by a Model. The probability of
+// more is negligible for realistic expected goals.
+const maxGoals = 15
+
+// Model holds the probability of each correct score of a match.
+type Model struct {
+	// matrix[h][a] is the probability of the home side scoring h and the away side
+	// a goals.
+	matrix [][]float64
+}
+
+// newModel returns the Model of the correct score probabilities given by prob,
+// normalized to sum to one.
+func newModel(prob func(home, away int) float64) Model {
+	matrix := make([][]float64, maxGoals+1)
+	total := 0.0
+	for h := range matrix {
+		matrix[h] = make([]float64, maxGoals+1)
+		for a := range matrix[h] {
+			matrix[h][a] = prob(h, a)
+			total += matrix[h][a]
+		}
+	}
+	for h := range matrix {
+		for a := range matrix[h] {
+			matrix[h][a] /= total
+		}
+	}
+	return Model{matrix: matrix}
+}
+
+// poisson returns the probability of k events from a Poisson distribution with
+// mean lambda.
+func poisson(k int, lambda float64) float64 {
+	if lambda == 0.0 {
+		if k == 0 {
+			return 1.0
+		}
+		return 0.0
+	}
+	lgamma, _ := math.Lgamma(float64(k) + 1.0)
+	return math.Exp(float64(k)*math.Log(lambda) - lambda - lgamma)
+}
+
+// NewPoissonModel returns the Model of a match where the home and away sides score
+// independent Poisson distributed goals with the given expected goals.
+// https://en.wikipedia.org/wiki/Poisson_distribution
+func NewPoissonModel(homeGoals, awayGoals float64) Model {
+	return newModel(func(h, a int) float64 {
+		return poisson(h, homeGoals) * poisson(a, awayGoals)
+	})
+}
+
+// NewDixonColesModel returns the Model of a match where the home and away sides
+// score Poisson distributed goals with the given expected goals, with the
+// probabilities of the 0-0, 1-0, 0-1 and 1-1 scores adjusted by the dependence
+// parameter rho. Fitted values of rho are typically small and negative, raising the
+// probability of the low scoring draws that independent goals underprice.
+// https://doi.org/10.1111/1467-9876.00065
+func NewDixonColesModel(homeGoals, awayGoals, rho float64) Model {
+	return newModel(func(h, a int) float64 {
+		tau := 1.0
+		switch {
+		case h == 0 && a == 0:
+			tau = 1.0 - homeGoals*awayGoals*rho
+		case h == 0 && a == 1:
+			tau = 1.0 + homeGoals*rho
+		case h == 1 && a == 0:
+			tau = 1.0 + awayGoals*rho
+		case h == 1 && a == 1:
+			tau = 1.0 - rho
+		}
+		return tau * poisson(h, homeGoals) * poisson(a, awayGoals)
+	})
+}
+
+// NewBivariatePoissonModel returns the Model of a match where the home and away
+// sides score Poisson distributed goals with the given expected goals and the given
+// covariance, which must be less than both. A positive covariance, as when both
+// sides score freely in an open match, raises the probability of draws.
+// https://doi.org/10.1111/1467-9884.00366
+func NewBivariatePoissonModel(homeGoals, awayGoals, covariance float64) Model {
+	home := homeGoals - covariance
+	away := awayGoals - covariance
+	return newModel(func(h, a int) float64 {
+		// Each side's goals are its own plus a shared Poisson count.
+		prob := 0.0
+		for k := 0; k <= h && k <= a; k++ {
+			prob += poisson(h-k, home) * poisson(a-k, away) * poisson(k, covariance)
+		}
+		return prob
+	})
+}
+
+// Score returns the probability of the match finishing with the given score.
+func (m Model) Score(home, away int) wagering.Probability {
+	if home < 0 || away < 0 || home > maxGoals || away > maxGoals {
+		return wagering.NewProbabilityFromDecimal(0.0)
+	}
+	return wagering.NewProbabilityFromDecimal(m.matrix[home][away])
+}
+
+// Matrix returns the correct score matrix, where element [h][a] is the probability
+// of the home side scoring h and the away side a goals.
+func (m Model) Matrix() [][]float64 {
+	matrix := make([][]float64, len(m.matrix))
+	for h := range m.matrix {
+		matrix[h] = append([]float64(nil), m.matrix[h]...)
+	}
+	return matrix
+}
+
+// sum returns the total probability of the scores for which include is true.
+func (m Model) sum(include func(home, away int) bool) float64 {
+	total := 0.0
+	for h := range m.matrix {
+		for a, p := range m.matrix[h] {
+			if include(h, a) {
+				total += p
+			}
+		}
+	}
+	return total
+}
+
+// Result returns the probabilities of a home win, a draw and an away win, the 1X2
+// market.
+func (m Model) Result() (home, draw, away wagering.Probability) {
+	home = wagering.NewProbabilityFromDecimal(m.sum(func(h, a int) bool { return h > a }))
+	draw = wagering.NewProbabilityFromDecimal(m.sum(func(h, a int) bool { return h == a }))
+	away = wagering.NewProbabilityFromDecimal(m.sum(func(h, a int) bool { return h < a }))
+	return home, draw, away
+}
+
+// Total returns the probabilities of the total goals going over and under line.
+// On whole number lines the remaining probability is of a push.
+func (m Model) Total(line float64) (over, under wagering.Probability) {
+	over = wagering.NewProbabilityFromDecimal(m.sum(func(h, a int) bool { return float64(h+a) > line }))
+	under = wagering.NewProbabilityFromDecimal(m.sum(func(h, a int) bool { return float64(h+a) < line }))
+	return over, under
+}
+
+// BothTeamsToScore returns the probability of both sides scoring.
+func (m Model) BothTeamsToScore() wagering.Probability {
+	return wagering.NewProbabilityFromDecimal(m.sum(func(h, a int) bool { return h > 0 && a > 0 }))
+}
+
+// fairHandicapOdds returns the fair odds of an Asian handicap at line on the side
+// whose margin, its goals less its opponent's, is given by margin.
+func (m Model) fairHandicapOdds(line float64, margin func(home, away int) int) wagering.Odds {
+	// The return of a unit stake is linear in the decimal odds, slope*d + intercept,
+	// for every margin, so grade at two odds to find each.
+	low := wagering.NewAsianHandicap(line, wagering.NewOddsFromDecimal(2.0), 1.0)
+	high := wagering.NewAsianHandicap(line, wagering.NewOddsFromDecimal(3.0), 1.0)
+	slope := 0.0
+	intercept := 0.0
+	for h := range m.matrix {
+		for a, p := range m.matrix[h] {
+			_, lowReturn := low.Grade(float64(margin(h, a)))
+			_, highReturn := high.Grade(float64(margin(h, a)))
+			slope += p * (highReturn - lowReturn)
+			intercept += p * (lowReturn - 2.0*(highReturn-lowReturn))
+		}
+	}
+	return wagering.NewOddsFromDecimal((1.0 - intercept) / slope)
+}
+
+// AsianHandicap returns the fair odds of the home side at the Asian handicap line,
+// such as -0.75, and of the away side at the opposite line.
+func (m Model) AsianHandicap(line float64) (home, away wagering.Odds) {
+	home = m.fairHandicapOdds(line, func(h, a int) int { return h - a })
+	away = m.fairHandicapOdds(-line, func(h, a int) int { return a - h })
+	return home, away
+}
@@ -0,0 +1,100 @@
+package soccer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPoissonModel(t *testing.T) {
+	m := NewPoissonModel(1.5, 1.0)
+	assert.InDelta(t, 0.0821, m.Score(0, 0).Decimal(), 0.0001)
+	assert.InDelta(t, 0.2463, m.Score(1, 1).Decimal()+m.Score(1, 0).Decimal(), 0.0001)
+	assert.Equal(t, 0.0, m.Score(-1, 0).Decimal())
+
+	sum := 0.0
+	for _, row := range m.Matrix() {
+		for _, p := range row {
+			sum += p
+		}
+	}
+	assert.InDelta(t, 1.0, sum, 1e-12)
+}
+
+func TestModel_Result(t *testing.T) {
+	home, draw, away := NewPoissonModel(1.5, 1.0).Result()
+	assert.InDelta(t, 0.4879, home.Decimal(), 0.0001)
+	assert.InDelta(t, 0.2598, draw.Decimal(), 0.0001)
+	assert.InDelta(t, 0.2522, away.Decimal(), 0.0001)
+
+	home, _, away = NewPoissonModel(1.2, 1.2).Result()
+	assert.InDelta(t, home.Decimal(), away.Decimal(), 1e-12)
+}
+
+func TestModel_Total(t *testing.T) {
+	m := NewPoissonModel(1.5, 1.0)
+	// Total goals are Poisson with mean 2.5.
+	over, under := m.Total(2.5)
+	assert.InDelta(t, 0.4562, over.Decimal(), 0.0001)
+	assert.InDelta(t, 1.0, over.Decimal()+under.Decimal(), 1e-12)
+
+	over, under = m.Total(2.0)
+	assert.InDelta(t, 0.2565, 1.0-over.Decimal()-under.Decimal(), 0.0001)
+}
+
+func TestModel_BothTeamsToScore(t *testing.T) {
+	// (1 - e^-1.5)(1 - e^-1).
+	assert.InDelta(t, 0.4911, NewPoissonModel(1.5, 1.0).BothTeamsToScore().Decimal(), 0.0001)
+}
+
+func TestModel_AsianHandicap(t *testing.T) {
+	m := NewPoissonModel(1.5, 1.0)
+	home, away := m.AsianHandicap(-0.5)
+	homeWin, draw, awayWin := m.Result()
+	assert.InDelta(t, 1.0/homeWin.Decimal(), home.Decimal(), 1e-9)
+	assert.InDelta(t, 1.0/(draw.Decimal()+awayWin.Decimal()), away.Decimal(), 1e-9)
+
+	// Level ball returns the stake on a draw, draw no bet.
+	home, _ = m.AsianHandicap(0.0)
+	assert.InDelta(t, (1.0-draw.Decimal())/homeWin.Decimal(), home.Decimal(), 1e-9)
+
+	// The quarter line sits between its component lines.
+	quarter, _ := m.AsianHandicap(-0.25)
+	assert.Greater(t, quarter.Decimal(), home.Decimal())
+	assert.Less(t, quarter.Decimal(), 1.0/homeWin.Decimal())
+}
+
+func TestNewDixonColesModel(t *testing.T) {
+	poisson := NewPoissonModel(1.5, 1.0)
+	assert.Equal(t, poisson, NewDixonColesModel(1.5, 1.0, 0.0))
+
+	m := NewDixonColesModel(1.5, 1.0, -0.1)
+	assert.Greater(t, m.Score(0, 0).Decimal(), poisson.Score(0, 0).Decimal())
+	assert.Greater(t, m.Score(1, 1).Decimal(), poisson.Score(1, 1).Decimal())
+	assert.Less(t, m.Score(1, 0).Decimal(), poisson.Score(1, 0).Decimal())
+	_, draw, _ := m.Result()
+	_, poissonDraw, _ := poisson.Result()
+	assert.Greater(t, draw.Decimal(), poissonDraw.Decimal())
+}
+
+func TestNewBivariatePoissonModel(t *testing.T) {
+	poisson := NewPoissonModel(1.5, 1.0)
+	independent := NewBivariatePoissonModel(1.5, 1.0, 0.0)
+	for h, row := range poisson.Matrix() {
+		for a, p := range row {
+			assert.InDelta(t, p, independent.Score(h, a).Decimal(), 1e-12)
+		}
+	}
+
+	m := NewBivariatePoissonModel(1.5, 1.0, 0.2)
+	_, draw, _ := m.Result()
+	_, poissonDraw, _ := poisson.Result()
+	assert.Greater(t, draw.Decimal(), poissonDraw.Decimal())
+	// Each side's expected goals are unchanged.
+	expected := 0.0
+	for h, row := range m.Matrix() {
+		for _, p := range row {
+			expected += float64(h) * p
+		}
+	}
+	assert.InDelta(t, 1.5, expected, 1e-6)
+}
@@ -0,0 +1,24 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDrawNoBet(t *testing.T) {
+	home, away := DrawNoBet(NewProbabilityFromDecimal(0.5), NewProbabilityFromDecimal(0.25), NewProbabilityFromDecimal(0.25))
+	assert.InDelta(t, 1.5, home.Decimal(), 1e-12)
+	assert.InDelta(t, 3.0, away.Decimal(), 1e-12)
+
+	fair := EqualMarginProbs(sampleOdds1()...)
+	home, away = DrawNoBet(fair[0], fair[1], fair[2])
+	// Both sides together form a fair two way market.
+	assert.InDelta(t, 1.0, 1.0/home.Decimal()+1.0/away.Decimal(), 1e-12)
+}
+
+func TestDoubleChance(t *testing.T) {
+	homeOrDraw, homeOrAway, drawOrAway := DoubleChance(NewProbabilityFromDecimal(0.5), NewProbabilityFromDecimal(0.25), NewProbabilityFromDecimal(0.25))
+	assert.InDelta(t, 1.3333, homeOrDraw.Decimal(), 0.0001)
+	assert.InDelta(t, 1.3333, homeOrAway.Decimal(), 0.0001)
+	assert.InDelta(t, 2.0, drawOrAway.Decimal(), 1e-12)
+}
@@ -0,0 +1,173 @@
+package wagering
+
+import (
+	"math"
+)
+
+// StakingPlan determines the amount to wager on an opportunity.
+type StakingPlan interface {
+	// Stake returns the amount to wager at odds, with the given probability of
+	// winning, from the current bankroll.
+	Stake(odds Odds, prob Probability, bankroll float64) float64
+}
+
+// StakeFunc adapts a function to a StakingPlan.
+type StakeFunc func(odds Odds, prob Probability, bankroll float64) float64
+
+// Stake returns f(odds, prob, bankroll).
+func (f StakeFunc) Stake(odds Odds, prob Probability, bankroll float64) float64 {
+	return f(odds, prob, bankroll)
+}
+
+// FlatStake is a StakingPlan wagering the same amount on every opportunity.
+type FlatStake struct {
+	Amount float64
+}
+
+// Stake returns the flat amount.
+func (fs FlatStake) Stake(Odds, Probability, float64) float64 {
+	return fs.Amount
+}
+
+// PercentStake is a StakingPlan wagering a percent, 0 to 100, of the current
+// bankroll on every opportunity.
+type PercentStake struct {
+	Percent float64
+}
+
+// Stake returns the percent of the bankroll.
+func (ps PercentStake) Stake(_ Odds, _ Probability, bankroll float64) float64 {
+	return bankroll * ps.Percent / 100.0
+}
+
+// KellyStake is a StakingPlan wagering the Kelly stake, scaled by the multiplier,
+// on every opportunity.
+type KellyStake struct {
+	Multiplier float64
+	// Increment, if positive, is the increment the stake is rounded down to so that
+	// the Kelly stake is never exceeded.
+	Increment float64
+}
+
+// Stake returns the Kelly stake scaled by the multiplier.
+func (ks KellyStake) Stake(odds Odds, prob Probability, bankroll float64) float64 {
+	return RoundStake(odds.KellyStake(prob, ks.Multiplier, bankroll), ks.Increment, RoundDown)
+}
+
+// UnitStake is a StakingPlan wagering a fixed number of units of a fixed size on
+// every opportunity.
+type UnitStake struct {
+	Unit  float64
+	Units float64
+}
+
+// Stake returns the units multiplied by the unit size.
+func (us UnitStake) Stake(Odds, Probability, float64) float64 {
+	return us.Unit * us.Units
+}
+
+// RoundMode is the direction in which RoundStake rounds.
+type RoundMode int
+
+const (
+	// RoundNearest rounds to the nearest increment, halves away from zero.
+	RoundNearest RoundMode = iota
+	// RoundDown rounds down to an increment.
+	RoundDown
+	// RoundUp rounds up to an increment.
+	RoundUp
+)
+
+// RoundStake returns the stake rounded to a multiple of increment, for example 1.0
+// for whole currency units or 0.5, in the direction given by mode. The stake is
+// returned unchanged if increment is not positive.
+func RoundStake(stake, increment float64, mode RoundMode) float64 {
+	if increment <= 0.0 {
+		return stake
+	}
+	units := stake / increment
+	// Guard against representation error, such as 0.3/0.1 being 2.9999999999999996.
+	if r := math.Round(units); math.Abs(units-r) < 1e-9 {
+		units = r
+	}
+	switch mode {
+	case RoundDown:
+		units = math.Floor(units)
+	case RoundUp:
+		units = math.Ceil(units)
+	default:
+		units = math.Round(units)
+	}
+	return units * increment
+}
+
+// HistoricalBet is a settled wager opportunity, the odds taken and the estimated
+// probability of winning along with its actual result.
+type HistoricalBet struct {
+	Leg
+	Result Result
+}
+
+// StakingSummary summarizes the bankroll of replaying historical bets with a
+// staking plan.
+type StakingSummary struct {
+	// FinalBankroll is the bankroll after the last bet.
+	FinalBankroll float64
+	// MaxDrawdown is the largest peak to trough fall in bankroll as a decimal of
+	// the peak.
+	MaxDrawdown float64
+	// Volatility is the standard deviation of the per bet change in the log of the
+	// bankroll.
+	Volatility float64
+}
+
+// Replay returns the summary of wagering, in order, on each of the historical bets
+// from the starting bankroll, staking by plan. Stakes are limited to the current
+// bankroll and the replay ends if the bankroll is lost.
+func Replay(bankroll float64, bets []HistoricalBet, plan StakingPlan) StakingSummary {
+	peak := bankroll
+	var summary StakingSummary
+	var growth []float64
+	for _, b := range bets {
+		if bankroll <= 0.0 {
+			break
+		}
+		s := math.Min(math.Max(plan.Stake(b.Odds, b.Prob, bankroll), 0.0), bankroll)
+		next := bankroll + s*(settledOdds(b.Odds, b.Result)-1.0)
+		if next > 0.0 {
+			growth = append(growth, math.Log(next/bankroll))
+		}
+		bankroll = next
+		peak = math.Max(peak, bankroll)
+		summary.MaxDrawdown = math.Max(summary.MaxDrawdown, (peak-bankroll)/peak)
+	}
+	summary.FinalBankroll = bankroll
+	if len(growth) > 1 {
+		m := mean(growth)
+		variance := 0.0
+		for _, g := range growth {
+			variance += (g - m) * (g - m)
+		}
+		summary.Volatility = math.Sqrt(variance / float64(len(growth)-1))
+	}
+	return summary
+}
+
+// StakingComparison holds the summaries of replaying the same historical bets with
+// flat, percentage and Kelly staking.
+type StakingComparison struct {
+	Flat    StakingSummary
+	Percent StakingSummary
+	Kelly   StakingSummary
+}
+
+// CompareStaking replays the historical bets from the starting bankroll side by
+// side wagering a flat amount on each, a percent, 0 to 100, of the current
+// bankroll on each, and the Kelly stake with the given multiplier on each.
+func CompareStaking(bankroll float64, bets []HistoricalBet, flat, percent, kellyMult float64) StakingComparison {
+	return StakingComparison{
+		Flat:    Replay(bankroll, bets, FlatStake{Amount: flat}),
+		Percent: Replay(bankroll, bets, PercentStake{Percent: percent}),
+		Kelly:   Replay(bankroll, bets, KellyStake{Multiplier: kellyMult}),
+	}
+}
@@ -0,0 +1,66 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func historicalBets() []HistoricalBet {
+	leg := Leg{NewOddsFromDecimal(2.0), NewProbabilityFromDecimal(0.6)}
+	return []HistoricalBet{{leg, Win}, {leg, Loss}, {leg, Win}}
+}
+
+func TestReplay(t *testing.T) {
+	bets := historicalBets()[1:]
+	summary := Replay(1000.0, bets, FlatStake{Amount: 600.0})
+	// The second bet is limited to the 400 remaining.
+	assert.InDelta(t, 800.0, summary.FinalBankroll, 1e-9)
+	assert.InDelta(t, 0.6, summary.MaxDrawdown, 1e-9)
+
+	bets[1].Result = Loss
+	summary = Replay(1000.0, bets, FlatStake{Amount: 600.0})
+	assert.Equal(t, StakingSummary{FinalBankroll: 0.0, MaxDrawdown: 1.0}, summary)
+}
+
+func TestCompareStaking(t *testing.T) {
+	comparison := CompareStaking(1000.0, historicalBets(), 100.0, 10.0, 1.0)
+	assert.InDelta(t, 1100.0, comparison.Flat.FinalBankroll, 1e-9)
+	assert.InDelta(t, 0.0909, comparison.Flat.MaxDrawdown, 0.0001)
+	assert.InDelta(t, 1089.0, comparison.Percent.FinalBankroll, 1e-9)
+	assert.InDelta(t, 0.1, comparison.Percent.MaxDrawdown, 1e-9)
+	assert.InDelta(t, 1152.0, comparison.Kelly.FinalBankroll, 1e-9)
+	assert.InDelta(t, 0.2, comparison.Kelly.MaxDrawdown, 1e-9)
+	assert.InDelta(t, 0.2341, comparison.Kelly.Volatility, 0.0001)
+	assert.Less(t, comparison.Percent.Volatility, comparison.Kelly.Volatility)
+}
+
+func TestStakingPlans(t *testing.T) {
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	var plans = []struct {
+		plan     StakingPlan
+		expected float64
+	}{
+		{FlatStake{Amount: 50.0}, 50.0},
+		{PercentStake{Percent: 2.0}, 40.0},
+		{KellyStake{Multiplier: 0.5}, 200.0},
+		{UnitStake{Unit: 25.0, Units: 1.5}, 37.5},
+		{StakeFunc(func(_ Odds, _ Probability, bankroll float64) float64 { return bankroll / 4.0 }), 500.0},
+	}
+	for _, p := range plans {
+		assert.InDelta(t, p.expected, p.plan.Stake(odds, prob, 2000.0), 1e-9)
+	}
+}
+
+func TestRoundStake(t *testing.T) {
+	assert.Equal(t, 12.5, RoundStake(12.74, 0.5, RoundNearest))
+	assert.Equal(t, 12.5, RoundStake(12.99, 0.5, RoundDown))
+	assert.Equal(t, 13.0, RoundStake(12.51, 0.5, RoundUp))
+	assert.Equal(t, 13.0, RoundStake(12.5, 1.0, RoundNearest))
+	assert.InDelta(t, 0.3, RoundStake(0.3, 0.1, RoundDown), 1e-12)
+	assert.Equal(t, 12.34, RoundStake(12.34, 0.0, RoundUp))
+
+	odds := NewOddsFromDecimal(2.0)
+	prob := NewProbabilityFromDecimal(0.6)
+	assert.Equal(t, 95.0, KellyStake{Multiplier: 0.5, Increment: 5.0}.Stake(odds, prob, 999.0))
+}
@@ -0,0 +1,197 @@
+package wagering
+
+import (
+	"math"
+	"sort"
+)
+
+// sorted returns a sorted copy of values.
+func sorted(values []float64) []float64 {
+	s := append([]float64(nil), values...)
+	sort.Float64s(s)
+	return s
+}
+
+// quantile returns the q quantile, 0 to 1, of the already sorted values using
+// linear interpolation between the closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (pos-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// mean returns the arithmetic mean of values.
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// HistogramBin is a bin of a histogram counting the values in [Low, High).
+type HistogramBin struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// histogram returns the bins of the given width, aligned to multiples of width,
+// spanning the values.
+func histogram(values []float64, width float64) []HistogramBin {
+	if len(values) == 0 {
+		return nil
+	}
+	s := sorted(values)
+	first := math.Floor(s[0] / width)
+	last := math.Floor(s[len(s)-1] / width)
+	bins := make([]HistogramBin, int(last-first)+1)
+	for i := range bins {
+		bins[i].Low = (first + float64(i)) * width
+		bins[i].High = (first + float64(i) + 1) * width
+	}
+	for _, v := range s {
+		bins[int(math.Floor(v/width)-first)].Count++
+	}
+	return bins
+}
+
+// normalCDF returns the cumulative distribution function of the standard normal
+// distribution at x.
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// normalQuantile returns the inverse of the cumulative distribution function of the
+// standard normal distribution at p.
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2.0*p-1.0)
+}
+
+// betaCF evaluates the continued fraction for the regularized incomplete beta
+// function by the modified Lentz's method.
+func betaCF(a, b, x float64) float64 {
+	const maxIterations = 300
+	const epsilon = 1e-15
+	const tiny = 1e-300
+	qab := a + b
+	qap := a + 1.0
+	qam := a - 1.0
+	c := 1.0
+	d := 1.0 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1.0 / d
+	h := d
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		m2 := 2.0 * fm
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1.0 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1.0 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		h *= d * c
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1.0 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1.0 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1.0) < epsilon {
+			break
+		}
+	}
+	return h
+}
+
+// betaCDF returns the cumulative distribution function of the Beta(a, b)
+// distribution at x, the regularized incomplete beta function.
+func betaCDF(a, b, x float64) float64 {
+	if x <= 0.0 {
+		return 0.0
+	} else if x >= 1.0 {
+		return 1.0
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log(1.0-x))
+	if x < (a+1.0)/(a+b+2.0) {
+		return front * betaCF(a, b, x) / a
+	}
+	return 1.0 - front*betaCF(b, a, 1.0-x)/b
+}
+
+// betaQuantile returns the inverse of the cumulative distribution function of the
+// Beta(a, b) distribution at p, found by bisection.
+func betaQuantile(a, b, p float64) float64 {
+	low, high := 0.0, 1.0
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2.0
+		if betaCDF(a, b, mid) < p {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2.0
+}
+
+// IntervalMethod is a method of computing a confidence interval for a binomial
+// proportion, such as a win rate.
+type IntervalMethod int
+
+const (
+	// Wilson is the Wilson score interval.
+	// https://en.wikipedia.org/wiki/Binomial_proportion_confidence_interval#Wilson_score_interval
+	Wilson IntervalMethod = iota
+	// Jeffreys is the Bayesian interval from the Jeffreys prior.
+	// https://en.wikipedia.org/wiki/Binomial_proportion_confidence_interval#Jeffreys_interval
+	Jeffreys
+)
+
+// ConfidenceInterval returns the two sided confidence interval, at the given level
+// such as 0.95, for the probability of winning given a record of wins and losses.
+func ConfidenceInterval(wins, losses int, level float64, method IntervalMethod) (low, high Probability) {
+	n := float64(wins + losses)
+	w := float64(wins)
+	alpha := 1.0 - level
+	if method == Jeffreys {
+		l, h := 0.0, 1.0
+		if wins > 0 {
+			l = betaQuantile(w+0.5, n-w+0.5, alpha/2.0)
+		}
+		if losses > 0 {
+			h = betaQuantile(w+0.5, n-w+0.5, 1.0-alpha/2.0)
+		}
+		return NewProbabilityFromDecimal(l), NewProbabilityFromDecimal(h)
+	}
+	z := normalQuantile(1.0 - alpha/2.0)
+	p := w / n
+	center := (p + z*z/(2.0*n)) / (1.0 + z*z/n)
+	spread := z / (1.0 + z*z/n) * math.Sqrt(p*(1.0-p)/n+z*z/(4.0*n*n))
+	return NewProbabilityFromDecimal(center - spread), NewProbabilityFromDecimal(center + spread)
+}
+
+// IntervalOdds returns the range of fair odds for a probability interval, the
+// shortest odds at the high probability and the longest at the low probability.
+func IntervalOdds(low, high Probability) (shortest, longest Odds) {
+	return NewOddsFromDecimal(1.0 / high.decimal), NewOddsFromDecimal(1.0 / low.decimal)
+}
@@ -0,0 +1,72 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestQuantile(t *testing.T) {
+	values := sorted([]float64{4.0, 1.0, 3.0, 2.0, 5.0})
+	assert.Equal(t, []float64{1.0, 2.0, 3.0, 4.0, 5.0}, values)
+	assert.Equal(t, 1.0, quantile(values, 0.0))
+	assert.Equal(t, 3.0, quantile(values, 0.5))
+	assert.Equal(t, 5.0, quantile(values, 1.0))
+	assert.Equal(t, 1.4, quantile(values, 0.1))
+	assert.True(t, math.IsNaN(quantile(nil, 0.5)))
+}
+
+func TestMean(t *testing.T) {
+	assert.Equal(t, 2.5, mean([]float64{1.0, 2.0, 3.0, 4.0}))
+}
+
+func TestHistogram(t *testing.T) {
+	bins := histogram([]float64{-0.03, -0.01, 0.0, 0.01, 0.015, 0.04}, 0.02)
+	assert.Len(t, bins, 5)
+	var counts []int
+	for _, b := range bins {
+		counts = append(counts, b.Count)
+	}
+	assert.Equal(t, []int{1, 1, 3, 0, 1}, counts)
+	assert.InDelta(t, -0.04, bins[0].Low, 1e-9)
+	assert.InDelta(t, 0.06, bins[4].High, 1e-9)
+	assert.Nil(t, histogram(nil, 0.02))
+}
+
+func TestNormalCDF(t *testing.T) {
+	assert.InDelta(t, 0.5, normalCDF(0.0), 1e-9)
+	assert.InDelta(t, 0.9772, normalCDF(2.0), 0.0001)
+	assert.InDelta(t, 0.0228, normalCDF(-2.0), 0.0001)
+}
+
+func TestNormalQuantile(t *testing.T) {
+	assert.InDelta(t, 1.96, normalQuantile(0.975), 0.001)
+	assert.InDelta(t, 0.0, normalQuantile(0.5), 1e-9)
+}
+
+func TestBetaCDF(t *testing.T) {
+	assert.InDelta(t, 0.5, betaCDF(2.0, 2.0, 0.5), 1e-9)
+	assert.InDelta(t, 0.25, betaCDF(1.0, 1.0, 0.25), 1e-9)
+	assert.InDelta(t, 0.0257, betaCDF(3.0, 5.0, 0.1), 0.0001)
+	assert.InDelta(t, 0.1, betaCDF(3.0, 5.0, betaQuantile(3.0, 5.0, 0.1)), 1e-9)
+}
+
+func TestConfidenceInterval(t *testing.T) {
+	low, high := ConfidenceInterval(55, 45, 0.95, Wilson)
+	assert.InDelta(t, 0.4524, low.decimal, 0.0001)
+	assert.InDelta(t, 0.6438, high.decimal, 0.0001)
+
+	low, high = ConfidenceInterval(55, 45, 0.95, Jeffreys)
+	assert.InDelta(t, 0.4522, low.decimal, 0.0001)
+	assert.InDelta(t, 0.6449, high.decimal, 0.0001)
+
+	low, high = ConfidenceInterval(10, 0, 0.95, Jeffreys)
+	assert.Equal(t, 1.0, high.decimal)
+	assert.Less(t, low.decimal, 1.0)
+}
+
+func TestIntervalOdds(t *testing.T) {
+	shortest, longest := IntervalOdds(NewProbabilityFromDecimal(0.4), NewProbabilityFromDecimal(0.5))
+	assert.Equal(t, 2.0, shortest.decimalOdds)
+	assert.Equal(t, 2.5, longest.decimalOdds)
+}
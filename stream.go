@@ -0,0 +1,186 @@
+package wagering
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Quote is a price for an outcome of a market quoted by a book at a point in time,
+// as received from a live feed.
+type Quote struct {
+	Event   string
+	Market  string
+	Book    string
+	Outcome string
+	Odds    Odds
+	Time    time.Time
+	// Fair is the fair probability of the outcome, zero until set by a pricing
+	// stage such as DevigStage or ConsensusStage.
+	Fair Probability
+	// EV is the expected value of wagering at Odds against Fair, as the percent
+	// increase or decrease (negative) of the wager, set by EVStage.
+	EV float64
+}
+
+// Stage is a step of a quote pipeline that reads quotes from in and writes quotes
+// to the returned channel, closing it once in is closed or ctx is done.
+type Stage func(ctx context.Context, in <-chan Quote) <-chan Quote
+
+// Pipeline connects in through each of the stages in order and returns the output
+// of the last stage.
+func Pipeline(ctx context.Context, in <-chan Quote, stages ...Stage) <-chan Quote {
+	out := in
+	for _, stage := range stages {
+		out = stage(ctx, out)
+	}
+	return out
+}
+
+// FanIn merges the quotes of the sources into a single channel that is closed once
+// every source is closed or ctx is done.
+func FanIn(ctx context.Context, sources ...<-chan Quote) <-chan Quote {
+	out := make(chan Quote)
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func(source <-chan Quote) {
+			defer wg.Done()
+			for q := range source {
+				if !send(ctx, out, q) {
+					return
+				}
+			}
+		}(source)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// send sends q on out, returning false if ctx is done first.
+func send(ctx context.Context, out chan<- Quote, q Quote) bool {
+	select {
+	case out <- q:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// stage returns a Stage that passes each quote read to process, sending the quotes
+// it returns.
+func stage(process func(q Quote) []Quote) Stage {
+	return func(ctx context.Context, in <-chan Quote) <-chan Quote {
+		out := make(chan Quote)
+		go func() {
+			defer close(out)
+			for {
+				select {
+				case q, ok := <-in:
+					if !ok {
+						return
+					}
+					for _, p := range process(q) {
+						if !send(ctx, out, p) {
+							return
+						}
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return out
+	}
+}
+
+// bookMarket identifies the market of an event quoted by a book.
+type bookMarket struct {
+	event  string
+	market string
+	book   string
+}
+
+// marketOutcome identifies an outcome of the market of an event.
+type marketOutcome struct {
+	event   string
+	market  string
+	outcome string
+}
+
+// DevigStage returns a Stage that keeps the latest quote of each outcome of each
+// book's markets and, once a market has quotes for all of its outcomes, the given
+// number, sends every quote of that market with Fair set by removing the vig by
+// method each time one of them changes. Quotes of incomplete markets are held.
+func DevigStage(method DevigMethod, outcomes int) Stage {
+	latest := make(map[bookMarket]map[string]Quote)
+	return stage(func(q Quote) []Quote {
+		key := bookMarket{q.Event, q.Market, q.Book}
+		if latest[key] == nil {
+			latest[key] = make(map[string]Quote)
+		}
+		latest[key][q.Outcome] = q
+		if len(latest[key]) < outcomes {
+			return nil
+		}
+		var quotes []Quote
+		for _, lq := range latest[key] {
+			quotes = append(quotes, lq)
+		}
+		sort.Slice(quotes, func(i, j int) bool {
+			return quotes[i].Outcome < quotes[j].Outcome
+		})
+		var odds []Odds
+		for _, lq := range quotes {
+			odds = append(odds, lq.Odds)
+		}
+		fair := DevigProbs(method, odds...)
+		for i := range quotes {
+			quotes[i].Fair = fair[i]
+		}
+		return quotes
+	})
+}
+
+// ConsensusStage returns a Stage that keeps the latest Fair of each book for each
+// outcome and sends each quote with Fair replaced by the mean of those of all of
+// the books. Quotes without a Fair are dropped.
+func ConsensusStage() Stage {
+	latest := make(map[marketOutcome]map[string]float64)
+	return stage(func(q Quote) []Quote {
+		if q.Fair.decimal == 0.0 {
+			return nil
+		}
+		key := marketOutcome{q.Event, q.Market, q.Outcome}
+		if latest[key] == nil {
+			latest[key] = make(map[string]float64)
+		}
+		latest[key][q.Book] = q.Fair.decimal
+		var fairs []float64
+		for _, f := range latest[key] {
+			fairs = append(fairs, f)
+		}
+		q.Fair = NewProbabilityFromDecimal(mean(fairs))
+		return []Quote{q}
+	})
+}
+
+// EVStage returns a Stage that sets the EV of each quote against its Fair and sends
+// only those whose EV exceeds minEV, as a decimal such as 0.02 for 2%. Quotes
+// without a Fair are dropped.
+func EVStage(minEV float64) Stage {
+	return stage(func(q Quote) []Quote {
+		if q.Fair.decimal == 0.0 {
+			return nil
+		}
+		q.EV = q.Odds.ExpectedValueProb(q.Fair)
+		if q.EV <= minEV {
+			return nil
+		}
+		return []Quote{q}
+	})
+}
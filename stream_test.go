@@ -0,0 +1,85 @@
+package wagering
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func feed(quotes ...Quote) <-chan Quote {
+	ch := make(chan Quote)
+	go func() {
+		defer close(ch)
+		for _, q := range quotes {
+			ch <- q
+		}
+	}()
+	return ch
+}
+
+func collect(ch <-chan Quote) []Quote {
+	var quotes []Quote
+	for q := range ch {
+		quotes = append(quotes, q)
+	}
+	return quotes
+}
+
+func quote(book, outcome string, american float64) Quote {
+	return Quote{Event: "NYJ @ NE", Market: "moneyline", Book: book, Outcome: outcome,
+		Odds: NewOddsFromAmerican(american), Time: time.Unix(0, 0)}
+}
+
+func TestFanIn(t *testing.T) {
+	ctx := context.Background()
+	quotes := collect(FanIn(ctx, feed(quote("bookA", "NE", -110.0)), feed(quote("bookB", "NE", -105.0)), feed()))
+	assert.Len(t, quotes, 2)
+	assert.ElementsMatch(t, []string{"bookA", "bookB"}, []string{quotes[0].Book, quotes[1].Book})
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	sharp := feed(quote("sharp", "NE", -150.0), quote("sharp", "NYJ", +130.0))
+	soft := feed(quote("soft", "NE", -120.0), quote("soft", "NYJ", +100.0))
+	quotes := collect(Pipeline(ctx, FanIn(ctx, sharp, soft), DevigStage(Multiplicative, 2)))
+	assert.Len(t, quotes, 4)
+	for _, q := range quotes {
+		if q.Book == "sharp" && q.Outcome == "NE" {
+			assert.InDelta(t, 0.5798, q.Fair.decimal, 0.0001)
+		}
+	}
+
+	// Only the soft book, devigged alone, leaves no value, but against the sharp
+	// book's devigged price the soft NE price is a play.
+	soft = feed(quote("soft", "NE", -120.0), quote("soft", "NYJ", +100.0))
+	plays := collect(Pipeline(ctx, soft, DevigStage(Multiplicative, 2), EVStage(0.0)))
+	assert.Empty(t, plays)
+
+	sharp = feed(quote("sharp", "NE", -150.0), quote("sharp", "NYJ", +130.0))
+	soft = feed(quote("soft", "NE", -120.0), quote("soft", "NYJ", +100.0))
+
+	ordered := make(chan Quote)
+	go func() {
+		defer close(ordered)
+		for _, ch := range []<-chan Quote{sharp, soft} {
+			for q := range ch {
+				ordered <- q
+			}
+		}
+	}()
+	plays = collect(Pipeline(ctx, ordered, DevigStage(Multiplicative, 2), ConsensusStage(), EVStage(0.0)))
+	assert.Len(t, plays, 1)
+	assert.Equal(t, "soft", plays[0].Book)
+	assert.Equal(t, "NE", plays[0].Outcome)
+	assert.Greater(t, plays[0].EV, 0.0)
+}
+
+func TestPipeline_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Quote)
+	out := Pipeline(ctx, in, EVStage(0.0))
+	cancel()
+	_, ok := <-out
+	assert.False(t, ok)
+}
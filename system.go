@@ -0,0 +1,87 @@
+package wagering
+
+import "fmt"
+
+// SystemBet is a full cover bet made up of every combination of the listed sizes
+// over a fixed number of selections, such as the standard UK Trixie or Yankee.
+type SystemBet struct {
+	name       string
+	selections int
+	sizes      []int
+}
+
+var (
+	// Trixie is 3 doubles and a treble over 3 selections.
+	Trixie = SystemBet{"Trixie", 3, []int{2, 3}}
+	// Patent is 3 singles, 3 doubles, and a treble over 3 selections.
+	Patent = SystemBet{"Patent", 3, []int{1, 2, 3}}
+	// Yankee is 6 doubles, 4 trebles, and a fourfold over 4 selections.
+	Yankee = SystemBet{"Yankee", 4, []int{2, 3, 4}}
+	// Lucky15 is a Yankee plus 4 singles.
+	Lucky15 = SystemBet{"Lucky 15", 4, []int{1, 2, 3, 4}}
+	// Canadian, also known as a Super Yankee, is 26 bets over 5 selections.
+	Canadian = SystemBet{"Canadian", 5, []int{2, 3, 4, 5}}
+	// Lucky31 is a Canadian plus 5 singles.
+	Lucky31 = SystemBet{"Lucky 31", 5, []int{1, 2, 3, 4, 5}}
+	// Heinz is 57 bets over 6 selections.
+	Heinz = SystemBet{"Heinz", 6, []int{2, 3, 4, 5, 6}}
+	// Lucky63 is a Heinz plus 6 singles.
+	Lucky63 = SystemBet{"Lucky 63", 6, []int{1, 2, 3, 4, 5, 6}}
+	// SuperHeinz is 120 bets over 7 selections.
+	SuperHeinz = SystemBet{"Super Heinz", 7, []int{2, 3, 4, 5, 6, 7}}
+	// Goliath is 247 bets over 8 selections.
+	Goliath = SystemBet{"Goliath", 8, []int{2, 3, 4, 5, 6, 7, 8}}
+)
+
+// Name returns the name of the system bet.
+func (sb SystemBet) Name() string {
+	return sb.name
+}
+
+// Selections returns the number of selections the system bet is made over.
+func (sb SystemBet) Selections() int {
+	return sb.selections
+}
+
+// Combinations returns the indices of the selections in each of the bets making
+// up the system bet.
+func (sb SystemBet) Combinations() [][]int {
+	var combos [][]int
+	for _, size := range sb.sizes {
+		combos = append(combos, combinations(sb.selections, size)...)
+	}
+	return combos
+}
+
+// Bets returns the number of bets making up the system bet.
+func (sb SystemBet) Bets() int {
+	return len(sb.Combinations())
+}
+
+// TotalStake returns the total amount wagered on the system bet when each bet is
+// wagered stake.
+func (sb SystemBet) TotalStake(stake float64) float64 {
+	return stake * float64(sb.Bets())
+}
+
+// Settle returns the total return, including stakes, of the system bet with each
+// bet wagered stake, for selections at the given odds with the given results.
+// Pushed selections are settled at odds of 1.0. It returns an error unless there
+// are odds and a result for each selection.
+func (sb SystemBet) Settle(odds []Odds, results []Result, stake float64) (float64, error) {
+	if len(odds) != sb.selections {
+		return 0.0, fmt.Errorf("%s of %d selections settled with %d odds", sb.name, sb.selections, len(odds))
+	}
+	if err := checkResults(results, sb.selections); err != nil {
+		return 0.0, err
+	}
+	total := 0.0
+	for _, combo := range sb.Combinations() {
+		payout := stake
+		for _, i := range combo {
+			payout *= settledOdds(odds[i], results[i])
+		}
+		total += payout
+	}
+	return total, nil
+}
@@ -0,0 +1,53 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSystemBet_Bets(t *testing.T) {
+	var expectedBets = []struct {
+		sb   SystemBet
+		bets int
+	}{
+		{Trixie, 4},
+		{Patent, 7},
+		{Yankee, 11},
+		{Lucky15, 15},
+		{Canadian, 26},
+		{Lucky31, 31},
+		{Heinz, 57},
+		{Lucky63, 63},
+		{SuperHeinz, 120},
+		{Goliath, 247},
+	}
+	for _, eb := range expectedBets {
+		assert.Equal(t, eb.bets, eb.sb.Bets(), "bets in %v", eb.sb.Name())
+	}
+	assert.Equal(t, 110.0, Yankee.TotalStake(10.0))
+}
+
+func TestSystemBet_Settle(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0), NewOddsFromDecimal(4.0)}
+	settle := func(sb SystemBet, results ...Result) float64 {
+		total, err := sb.Settle(odds, results, 10.0)
+		assert.NoError(t, err)
+		return total
+	}
+
+	// All win: doubles 6 + 8 + 12, treble 24.
+	assert.InDelta(t, 500.0, settle(Trixie, Win, Win, Win), 1e-9)
+	// One loses: only the 2.0 x 3.0 double returns.
+	assert.InDelta(t, 60.0, settle(Trixie, Win, Win, Loss), 1e-9)
+	// One pushes: doubles 6 + 2 + 3, treble 6.
+	assert.InDelta(t, 170.0, settle(Trixie, Win, Win, Push), 1e-9)
+	// Singles count in a Patent.
+	assert.InDelta(t, 20.0, settle(Patent, Win, Loss, Loss), 1e-9)
+
+	_, err := Trixie.Settle(odds, []Result{Win, Win}, 10.0)
+	assert.ErrorIs(t, err, ErrResultCount)
+	_, err = Trixie.Settle(odds[:2], []Result{Win, Win, Win}, 10.0)
+	assert.Error(t, err)
+	_, err = Yankee.Settle(odds, []Result{Win, Win, Win}, 10.0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package wagering
+
+import (
+	"fmt"
+	"math"
+)
+
+// TeaserLeg is a single leg of a teaser.
+type TeaserLeg struct {
+	// Line is the spread or total, from the perspective of the bettor, before
+	// teasing. For example, -7.5 for a favorite or +1.5 for an underdog.
+	Line float64
+	// Points is the number of points the line is teased in the bettor's favor.
+	// Pleaser legs, where points are sold, are negative.
+	Points float64
+	// Total is whether the leg is a total rather than a spread.
+	Total bool
+	// Prob is the probability that the leg wins at the teased line.
+	Prob Probability
+}
+
+// TeasedLine returns the line of the leg after teasing.
+func (leg TeaserLeg) TeasedLine() float64 {
+	return leg.Line + leg.Points
+}
+
+// IsWong returns whether the leg is a spread whose tease crosses both of the key
+// numbers 3 and 7, as described by Stanford Wong in Sharp Sports Betting. With a
+// standard 6 point tease these are favorites of -7.5 to -8.5 and underdogs of
+// +1.5 to +2.5.
+func (leg TeaserLeg) IsWong() bool {
+	if leg.Total {
+		return false
+	}
+	// The tease turns losses into wins at margins between -TeasedLine and -Line.
+	low, high := -leg.TeasedLine(), -leg.Line
+	crosses := func(margin float64) bool {
+		return low < margin && margin < high
+	}
+	return (crosses(3.0) && crosses(7.0)) || (crosses(-3.0) && crosses(-7.0))
+}
+
+// StandardTeaserOdds holds the typical payouts of 6 point football teasers by the
+// number of legs.
+var StandardTeaserOdds = map[int]Odds{
+	2: NewOddsFromAmerican(-120.0),
+	3: NewOddsFromAmerican(+160.0),
+	4: NewOddsFromAmerican(+260.0),
+	5: NewOddsFromAmerican(+400.0),
+	6: NewOddsFromAmerican(+600.0),
+}
+
+// StandardPleaserOdds holds the typical payouts of 6 point football pleasers by
+// the number of legs.
+var StandardPleaserOdds = map[int]Odds{
+	2: NewOddsFromAmerican(+600.0),
+	3: NewOddsFromAmerican(+1500.0),
+	4: NewOddsFromAmerican(+3000.0),
+	5: NewOddsFromAmerican(+5000.0),
+	6: NewOddsFromAmerican(+10000.0),
+}
+
+// Teaser is a parlay of legs teased to more favorable lines and paid at reduced
+// odds. A pleaser, or reverse teaser, is a Teaser whose legs are teased to less
+// favorable lines, by negative Points, and paid at increased odds.
+type Teaser struct {
+	odds  Odds
+	legs  []TeaserLeg
+	stake float64
+}
+
+// NewTeaser constructs a new Teaser paying the given odds for the given legs.
+func NewTeaser(odds Odds, legs ...TeaserLeg) Teaser {
+	return Teaser{odds: odds, legs: legs}
+}
+
+// NewStandardTeaser constructs a new Teaser for the given legs paying the odds in
+// StandardTeaserOdds for its number of legs, returning an error if there are no
+// standard odds for that many legs.
+func NewStandardTeaser(legs ...TeaserLeg) (Teaser, error) {
+	odds, ok := StandardTeaserOdds[len(legs)]
+	if !ok {
+		return Teaser{}, fmt.Errorf("no standard teaser odds for %d legs", len(legs))
+	}
+	return NewTeaser(odds, legs...), nil
+}
+
+// NewStandardPleaser constructs a new pleaser Teaser for the given legs paying the
+// odds in StandardPleaserOdds for its number of legs, returning an error if there
+// are no standard odds for that many legs.
+func NewStandardPleaser(legs ...TeaserLeg) (Teaser, error) {
+	odds, ok := StandardPleaserOdds[len(legs)]
+	if !ok {
+		return Teaser{}, fmt.Errorf("no standard pleaser odds for %d legs", len(legs))
+	}
+	return NewTeaser(odds, legs...), nil
+}
+
+// WithStake returns a copy of the teaser wagering stake, for settlement as a Bet.
+func (t Teaser) WithStake(stake float64) Teaser {
+	t.stake = stake
+	return t
+}
+
+// Stake returns the amount wagered on the teaser.
+func (t Teaser) Stake() float64 {
+	return t.stake
+}
+
+// Odds returns the odds the teaser pays.
+func (t Teaser) Odds() Odds {
+	return t.odds
+}
+
+// Legs returns the legs of the teaser.
+func (t Teaser) Legs() []TeaserLeg {
+	return t.legs
+}
+
+// BreakEvenProb returns the probability each leg must win, assuming the legs are
+// independent and equally likely, for the teaser to break even.
+func (t Teaser) BreakEvenProb() Probability {
+	return NewProbabilityFromDecimal(math.Pow(t.odds.ImpliedProb().decimal, 1.0/float64(len(t.legs))))
+}
+
+// Prob returns the probability that every leg of the teaser wins, assuming the
+// legs are independent.
+func (t Teaser) Prob() Probability {
+	prob := 1.0
+	for _, leg := range t.legs {
+		prob *= leg.Prob.decimal
+	}
+	return NewProbabilityFromDecimal(prob)
+}
+
+// ExpectedValue returns the long term expected value of the teaser. The result is
+// given as the percent increase or decrease (negative) of the wager.
+func (t Teaser) ExpectedValue() float64 {
+	return t.odds.ExpectedValueProb(t.Prob())
+}
+
+// IsWong returns whether every leg of the teaser is a Wong teaser leg.
+func (t Teaser) IsWong() bool {
+	for _, leg := range t.legs {
+		if !leg.IsWong() {
+			return false
+		}
+	}
+	return len(t.legs) > 0
+}
+
+// Settle returns the result and total return, including stake, of the teaser given
+// the results of each leg at its teased line. Teasers are settled with ties lose
+// rules, so the teaser is lost unless every leg wins.
+func (t Teaser) Settle(results ...Result) (Result, float64, error) {
+	if err := checkResults(results, len(t.legs)); err != nil {
+		return Loss, 0.0, err
+	}
+	for _, r := range results {
+		if r != Win {
+			return Loss, 0.0, nil
+		}
+	}
+	return Win, t.stake * t.odds.decimalOdds, nil
+}
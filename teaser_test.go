@@ -0,0 +1,84 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTeaserLeg_IsWong(t *testing.T) {
+	var expectedWong = []struct {
+		leg  TeaserLeg
+		wong bool
+	}{
+		{TeaserLeg{Line: -7.5, Points: 6.0}, true},
+		{TeaserLeg{Line: -8.5, Points: 6.0}, true},
+		{TeaserLeg{Line: +1.5, Points: 6.0}, true},
+		{TeaserLeg{Line: +2.5, Points: 6.0}, true},
+		{TeaserLeg{Line: -7.0, Points: 6.0}, false},
+		{TeaserLeg{Line: -9.5, Points: 6.0}, false},
+		{TeaserLeg{Line: +3.0, Points: 6.0}, false},
+		{TeaserLeg{Line: +1.5, Points: 6.0, Total: true}, false},
+	}
+	for _, ew := range expectedWong {
+		assert.Equal(t, ew.wong, ew.leg.IsWong(), "wong for %v teased %v", ew.leg.Line, ew.leg.Points)
+	}
+}
+
+// standardTeaser returns the NewStandardTeaser of legs, of a supported number.
+func standardTeaser(legs ...TeaserLeg) Teaser {
+	teaser, err := NewStandardTeaser(legs...)
+	if err != nil {
+		panic(err)
+	}
+	return teaser
+}
+
+func TestNewStandardTeaser(t *testing.T) {
+	teaser, err := NewStandardTeaser(TeaserLeg{}, TeaserLeg{}, TeaserLeg{})
+	assert.NoError(t, err)
+	assert.Equal(t, StandardTeaserOdds[3], teaser.Odds())
+
+	_, err = NewStandardTeaser(make([]TeaserLeg, 7)...)
+	assert.Error(t, err)
+	_, err = NewStandardTeaser()
+	assert.Error(t, err)
+}
+
+func TestTeaser_BreakEvenProb(t *testing.T) {
+	teaser := standardTeaser(TeaserLeg{Line: -7.5, Points: 6.0}, TeaserLeg{Line: +2.5, Points: 6.0})
+	assert.InDelta(t, 73.85, teaser.BreakEvenProb().percent, 0.01)
+
+	teaser = NewTeaser(NewOddsFromAmerican(+160.0), TeaserLeg{}, TeaserLeg{}, TeaserLeg{})
+	assert.InDelta(t, 72.72, teaser.BreakEvenProb().percent, 0.01)
+}
+
+func TestTeaser_ExpectedValue(t *testing.T) {
+	legs := []TeaserLeg{
+		{Line: -7.5, Points: 6.0, Prob: NewProbabilityFromPercent(75.0)},
+		{Line: +1.5, Points: 6.0, Prob: NewProbabilityFromPercent(76.0)},
+	}
+	teaser := standardTeaser(legs...)
+	assert.InDelta(t, 0.57, teaser.Prob().decimal, 1e-9)
+	assert.InDelta(t, 0.045, teaser.ExpectedValue(), 0.001)
+	assert.True(t, teaser.IsWong())
+
+	legs[1].Line = +4.5
+	assert.False(t, standardTeaser(legs...).IsWong())
+}
+
+func TestPleaser(t *testing.T) {
+	legs := []TeaserLeg{
+		{Line: -3.0, Points: -6.0, Prob: NewProbabilityFromPercent(35.0)},
+		{Line: +7.0, Points: -6.0, Prob: NewProbabilityFromPercent(40.0)},
+	}
+	pleaser, err := NewStandardPleaser(legs...)
+	assert.NoError(t, err)
+	assert.Equal(t, 7.0, pleaser.Odds().decimalOdds)
+	assert.Equal(t, -9.0, legs[0].TeasedLine())
+	assert.InDelta(t, 37.80, pleaser.BreakEvenProb().percent, 0.01)
+	assert.InDelta(t, -0.02, pleaser.ExpectedValue(), 1e-9)
+	assert.False(t, pleaser.IsWong())
+
+	_, err = NewStandardPleaser(append(legs, make([]TeaserLeg, 5)...)...)
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package wagering
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ValueBet is a price, at a book, on an outcome of a market that has positive
+// expected value against an estimate of its fair probability.
+type ValueBet struct {
+	Book string
+	// Outcome is the index of the outcome in the market.
+	Outcome int
+	Odds    Odds
+	Fair    Probability
+	// EV is the expected value of wagering at Odds, as the percent increase or
+	// decrease (negative) of the wager.
+	EV float64
+}
+
+// SharpValueBets treats the market quoted by the sharp book as the truth, devigging
+// it with method, and returns every price quoted by the other books with positive
+// expected value against those fair probabilities, ranked by expected value from
+// highest. The market is given as a map from book to the odds of each outcome in
+// the same order. An error is returned when the sharp book does not quote the
+// market or a book quotes a differing number of outcomes.
+func SharpValueBets(method DevigMethod, sharp string, books map[string][]Odds) ([]ValueBet, error) {
+	sharpOdds, ok := books[sharp]
+	if !ok {
+		return nil, fmt.Errorf("sharp book %s does not quote the market", sharp)
+	}
+	fair := DevigProbs(method, sharpOdds...)
+	var values []ValueBet
+	for b, odds := range books {
+		if b == sharp {
+			continue
+		}
+		if len(odds) != len(fair) {
+			return nil, fmt.Errorf("book %s quotes %d outcomes, expected %d", b, len(odds), len(fair))
+		}
+		for i, o := range odds {
+			if ev := o.ExpectedValueProb(fair[i]); ev > 0.0 {
+				values = append(values, ValueBet{Book: b, Outcome: i, Odds: o, Fair: fair[i], EV: ev})
+			}
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].EV != values[j].EV {
+			return values[i].EV > values[j].EV
+		}
+		if values[i].Book != values[j].Book {
+			return values[i].Book < values[j].Book
+		}
+		return values[i].Outcome < values[j].Outcome
+	})
+	return values, nil
+}
@@ -0,0 +1,30 @@
+package wagering
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSharpValueBets(t *testing.T) {
+	books := map[string][]Odds{
+		"pinnacle": {NewOddsFromDecimal(1.95), NewOddsFromDecimal(1.95)},
+		"bookA":    {NewOddsFromDecimal(2.1), NewOddsFromDecimal(1.8)},
+		"bookB":    {NewOddsFromDecimal(1.9), NewOddsFromDecimal(2.05)},
+	}
+	values, err := SharpValueBets(Multiplicative, "pinnacle", books)
+	assert.NoError(t, err)
+	assert.Len(t, values, 2)
+	assert.Equal(t, "bookA", values[0].Book)
+	assert.Equal(t, 0, values[0].Outcome)
+	assert.InDelta(t, 0.05, values[0].EV, 1e-9)
+	assert.InDelta(t, 0.5, values[0].Fair.decimal, 1e-9)
+	assert.Equal(t, "bookB", values[1].Book)
+	assert.Equal(t, 1, values[1].Outcome)
+	assert.InDelta(t, 0.025, values[1].EV, 1e-9)
+
+	_, err = SharpValueBets(Multiplicative, "circa", books)
+	assert.Error(t, err)
+	books["bookC"] = sampleOdds1()
+	_, err = SharpValueBets(Multiplicative, "pinnacle", books)
+	assert.Error(t, err)
+}
@@ -18,6 +18,7 @@ package wagering
 
 import (
 	"math"
+	"sort"
 )
 
 type Odds struct {
@@ -66,34 +67,50 @@ func (ao *AverageOdds) Accumulate(odds ...Odds) {
 	}
 }
 
-// Average returns the average Odds for the AverageOdds.
+// Add accumulates odds into AverageOdds, implementing Accumulator.
+func (ao *AverageOdds) Add(odds Odds) {
+	ao.Accumulate(odds)
+}
+
+// Count returns the number of Odds accumulated.
+func (ao *AverageOdds) Count() int {
+	return ao.count
+}
+
+// Average returns the average Odds for the AverageOdds, or the zero Odds if none
+// have been accumulated.
 func (ao *AverageOdds) Average() Odds {
-	return NewOddsFromDecimal(ao.sum / float64(ao.count))
+	odds, _ := ao.AverageOk()
+	return odds
+}
+
+// AverageOk returns the average Odds for the AverageOdds and whether any have been
+// accumulated, the zero Odds being returned if not.
+func (ao *AverageOdds) AverageOk() (Odds, bool) {
+	if ao.count == 0 {
+		return Odds{}, false
+	}
+	return NewOddsFromDecimal(ao.sum / float64(ao.count)), true
 }
 
 // AverageWithout returns the Odds for AverageOdds with a count of Odds removed.
 // This most obvious usage of this is to give the average odds while disregarding
-// a particular value that was already accumulated into AverageOdds.
+// a particular value that was already accumulated into AverageOdds. The zero Odds
+// is returned if no Odds remain.
 func (ao *AverageOdds) AverageWithout(odds Odds, count int) Odds {
+	if ao.count-count <= 0 {
+		return Odds{}
+	}
 	sum := ao.sum - (odds.decimalOdds * float64(count))
 	decimalOdds := sum / float64(ao.count-count)
 	return NewOddsFromDecimal(decimalOdds)
 }
 
-func probs(odds ...Odds) []Probability {
-	var probs []Probability
-	for _, o := range odds {
-		probs = append(probs, o.ImpliedProb())
-	}
-	return probs
-}
-
 // probSum returns the summation of the implied probabilities for the given odds.
 func probSum(odds ...Odds) float64 {
-	probs := probs(odds...)
 	probSum := 0.0
-	for _, p := range probs {
-		probSum += p.decimal
+	for _, o := range odds {
+		probSum += 1 / o.decimalOdds
 	}
 	return probSum
 }
@@ -102,6 +119,25 @@ func margin(odds ...Odds) float64 {
 	return probSum(odds...) - 1.0
 }
 
+// Overround returns the sum of the implied probabilities of the given odds, the
+// odds of every outcome of a market, which exceeds one by the bookmaker's margin.
+func Overround(odds ...Odds) float64 {
+	return probSum(odds...)
+}
+
+// HoldPercent returns the theoretical hold, as a percent, of a market at the given
+// odds, the percent of the total wagered the bookmaker keeps when wagers are
+// balanced to pay out the same whichever outcome wins.
+func HoldPercent(odds ...Odds) float64 {
+	return hold(odds...) * 100.0
+}
+
+// VigPerSide returns the margin, as a decimal probability, charged on each side of
+// a two way market at the given odds.
+func VigPerSide(odds1, odds2 Odds) float64 {
+	return margin(odds1, odds2) / 2.0
+}
+
 // American returns the american odds.
 func (odds Odds) American() float64 {
 	return odds.americanOdds
@@ -144,6 +180,51 @@ func (odds Odds) Shorter(other Odds) bool {
 	return odds.decimalOdds < other.decimalOdds
 }
 
+// Compare returns -1 if a is shorter than b, 1 if a is longer and 0 if they are
+// equal, ordering odds from shortest to longest in the manner of slices.SortFunc.
+func Compare(a, b Odds) int {
+	switch {
+	case a.Shorter(b):
+		return -1
+	case a.Longer(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SortShortest sorts odds in place from shortest to longest.
+func SortShortest(odds []Odds) {
+	sort.SliceStable(odds, func(i, j int) bool { return Compare(odds[i], odds[j]) < 0 })
+}
+
+// SortLongest sorts odds in place from longest to shortest.
+func SortLongest(odds []Odds) {
+	sort.SliceStable(odds, func(i, j int) bool { return Compare(odds[i], odds[j]) > 0 })
+}
+
+// LongestOdds returns the longest of odds, the zero Odds if there are none.
+func LongestOdds(odds ...Odds) Odds {
+	var longest Odds
+	for i, o := range odds {
+		if i == 0 || o.Longer(longest) {
+			longest = o
+		}
+	}
+	return longest
+}
+
+// ShortestOdds returns the shortest of odds, the zero Odds if there are none.
+func ShortestOdds(odds ...Odds) Odds {
+	var shortest Odds
+	for i, o := range odds {
+		if i == 0 || o.Shorter(shortest) {
+			shortest = o
+		}
+	}
+	return shortest
+}
+
 // ImpliedProb returns the implied probability of the given odds.
 // This computation is equivalent to the break even probability.
 func (odds Odds) ImpliedProb() Probability {
@@ -176,6 +257,38 @@ func MarketWidth(odds1, odds2 Odds) float64 {
 	}
 }
 
+// americanScale returns american odds on a continuous scale, closing the gap
+// between -100 and +100, so that differences of odds are in cents.
+func americanScale(odds Odds) float64 {
+	if odds.americanOdds < 0 {
+		return odds.americanOdds + 200.0
+	}
+	return odds.americanOdds
+}
+
+// NoVigWidth returns the width, in cents, of the two way pick'em market with the
+// same theoretical hold as the market at the given odds. Unlike MarketWidth, which
+// grows with the price of the favorite for the same hold, it measures the juice
+// removed by the no-vig line consistently between favorites and underdogs of any
+// size. See HoldToWidth.
+func NoVigWidth(odds1, odds2 Odds) float64 {
+	return HoldToWidth(HoldPercent(odds1, odds2))
+}
+
+// WidthToHold returns the theoretical hold, as a percent, of a two way pick'em
+// market of the given width in cents, each side priced at -(100 + width/2).
+func WidthToHold(width float64) float64 {
+	side := NewOddsFromAmerican(-(100.0 + width/2.0))
+	return HoldPercent(side, side)
+}
+
+// HoldToWidth returns the width, in cents, of a two way pick'em market with the
+// given theoretical hold as a percent. It is the inverse of WidthToHold.
+func HoldToWidth(holdPercent float64) float64 {
+	q := 1.0 / (1.0 - holdPercent/100.0) / 2.0
+	return 2.0 * (100.0*q/(1.0-q) - 100.0)
+}
+
 // Probability represents a probability and stores the decimal and percent
 // representations. By using Probability, instead of a float, the ambiguity
 // between passing the decimal or percent is removed.
@@ -194,6 +307,16 @@ func NewProbabilityFromDecimal(decimal float64) Probability {
 	return Probability{decimal, decimal * 100.0}
 }
 
+// Decimal returns the probability as a decimal, 0 to 1.
+func (prob Probability) Decimal() float64 {
+	return prob.decimal
+}
+
+// Percent returns the probability as a percent, 0 to 100.
+func (prob Probability) Percent() float64 {
+	return prob.percent
+}
+
 // Pro bettor nishikori says:
 // in Football, the methods that seem to come closest to the true odds are
 // "Margin proportional to odds" and "Logarithmic", whereas in Tennis are
@@ -207,122 +330,40 @@ func NewProbabilityFromDecimal(decimal float64) Probability {
 
 // EqualMarginOdds gives the odds of the given Odds using the method of simple normalization.
 func EqualMarginOdds(odds ...Odds) []Odds {
-	probSum := probSum(odds...)
-	var norms []Odds
-	for _, o := range odds {
-		norms = append(norms, NewOddsFromDecimal(o.decimalOdds*probSum))
-	}
-	return norms
+	return devigOdds(Multiplicative, odds)
 }
 
 // AdditiveOdds gives the odds of the given Odds by removing equal amounts of the margin.
 func AdditiveOdds(odds ...Odds) []Odds {
-	n := float64(len(odds))
-	m := margin(odds...)
-	var norms []Odds
-	for _, o := range odds {
-		prob := 1/o.decimalOdds - m/n
-		norms = append(norms, NewOddsFromDecimal(1/prob))
-	}
-	return norms
+	return devigOdds(Additive, odds)
 }
 
 // MPTOdds implements the "margin proportional to odds" approach.
 func MPTOdds(odds ...Odds) []Odds {
-	n := float64(len(odds))
-	m := margin(odds...)
-	var norms []Odds
-	for _, o := range odds {
-		norms = append(norms, NewOddsFromDecimal((n*o.decimalOdds)/(n-m*o.decimalOdds)))
-	}
-	return norms
+	return devigOdds(MPTO, odds)
 }
 
+// ShinOdds implements Shin's method, which models the margin as protection against
+// insider trading. Two outcome markets use the closed form solution, for which the
+// result matches AdditiveOdds, while larger markets are solved with Newton's
+// method. See ShinOddsWithOptions to control and diagnose the solver.
 // Largely taken from https://github.com/mberk/shin.
 func ShinOdds(odds ...Odds) []Odds {
-	// TODO(dburger): if n == 2 use additive
-	n := len(odds)
-	probs := probs(odds...)
-	overround := probSum(odds...)
-	delta := math.MaxFloat64
-	convergenceThreshold := 1e-12
-	z := 0.0
-	iterations := 0
-	maxIterations := 1000
-
-	for delta > convergenceThreshold && iterations < maxIterations {
-		z0 := z
-		z = -2.0
-		for _, p := range probs {
-			z += math.Sqrt(math.Pow(z0, 2) + 4*(1-z0)*math.Pow(p.decimal, 2)/overround)
-		}
-
-		// why isn't this division by zero on a two banger?
-		z /= (float64(n) - 2.0)
-		delta = math.Abs(z - z0)
-		iterations++
-	}
-
-	// Now use z to make the true odds.
-	var trueOdds []Odds
-	for _, p := range probs {
-		prob := (math.Sqrt(math.Pow(z, 2)+4*(1-z)*math.Pow(p.decimal, 2)/overround) - z) / (2 * (1 - z))
-		trueOdds = append(trueOdds, NewOddsFromDecimal(1/prob))
-	}
-	return trueOdds
+	return devigOdds(Shin, odds)
 }
 
+// OddsRatioOdds implements the odds ratio method. See OddsRatioOddsWithOptions to
+// control and diagnose the solver.
 // https://www.sportstradingnetwork.com/article/fixed-odds-betting-traditional-odds/
 func OddsRatioOdds(odds ...Odds) []Odds {
-	delta := math.MaxFloat64
-	convergenceThreshold := 1e-12
-	diff := 0.0
-	c := 1.0
-	iterations := 0
-	maxIterations := 1000
-	for delta > convergenceThreshold && iterations < maxIterations {
-		c -= diff
-		sum := 0.0
-		for _, o := range odds {
-			sum += 1 / (c*o.decimalOdds + 1 - c)
-		}
-		diff = 1.0 - sum
-		delta = math.Abs(diff)
-		iterations++
-	}
-
-	// Now use c to make the true odds.
-	var trueOdds []Odds
-	for _, o := range odds {
-		trueOdds = append(trueOdds, NewOddsFromDecimal(1/(1/(c*o.decimalOdds+1-c))))
-	}
-	return trueOdds
-
+	return devigOdds(OddsRatio, odds)
 }
 
+// LogarithmicOdds implements the logarithmic method, also known as the power
+// method, raising each implied probability to the power for which the
+// probabilities sum to one. See LogarithmicOddsWithOptions to control and diagnose
+// the solver.
+// https://www.football-data.co.uk/The_Wisdom_of_the_Crowd_updated.pdf
 func LogarithmicOdds(odds ...Odds) []Odds {
-	probs := probs(odds...)
-	delta := math.MaxFloat64
-	convergenceThreshold := 1e-12
-	diff := 0.0
-	c := 1.0
-	iterations := 0
-	maxIterations := 1000
-	for delta > convergenceThreshold && iterations < maxIterations {
-		c -= diff
-		sum := 0.0
-		for _, p := range probs {
-			sum += math.Pow(p.decimal, c)
-		}
-		diff = 1.0 - sum
-		delta = math.Abs(diff)
-		iterations++
-	}
-
-	// Now use c to make the true odds.
-	var trueOdds []Odds
-	for _, p := range probs {
-		trueOdds = append(trueOdds, NewOddsFromDecimal(1.0/math.Pow(p.decimal, c)))
-	}
-	return trueOdds
+	return devigOdds(Logarithmic, odds)
 }
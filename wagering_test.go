@@ -105,6 +105,25 @@ func TestOdds_Shorter(t *testing.T) {
 	assert.False(t, odds1.Shorter(odds2))
 }
 
+func TestCompare(t *testing.T) {
+	assert.Equal(t, -1, Compare(NewOddsFromDecimal(1.5), NewOddsFromDecimal(2.0)))
+	assert.Equal(t, 1, Compare(NewOddsFromAmerican(+150.0), NewOddsFromAmerican(-150.0)))
+	assert.Equal(t, 0, Compare(NewOddsFromDecimal(2.0), NewOddsFromAmerican(+100.0)))
+}
+
+func TestSortOdds(t *testing.T) {
+	odds := []Odds{NewOddsFromDecimal(2.0), NewOddsFromDecimal(1.5), NewOddsFromDecimal(3.0)}
+	SortShortest(odds)
+	assert.Equal(t, []Odds{NewOddsFromDecimal(1.5), NewOddsFromDecimal(2.0), NewOddsFromDecimal(3.0)}, odds)
+	SortLongest(odds)
+	assert.Equal(t, []Odds{NewOddsFromDecimal(3.0), NewOddsFromDecimal(2.0), NewOddsFromDecimal(1.5)}, odds)
+
+	assert.Equal(t, NewOddsFromDecimal(3.0), LongestOdds(odds...))
+	assert.Equal(t, NewOddsFromDecimal(1.5), ShortestOdds(odds...))
+	assert.Equal(t, Odds{}, LongestOdds())
+	assert.Equal(t, Odds{}, ShortestOdds())
+}
+
 func TestOdds_ExpectedValueProb(t *testing.T) {
 	odds := NewOddsFromAmerican(-110.0)
 	prob := NewProbabilityFromPercent(50.0)
@@ -221,6 +240,13 @@ func TestShinOdds(t *testing.T) {
 	assert.Equal(t, 0.3729941, round(trueOdds[0].ImpliedProb().decimal, 7))
 	assert.Equal(t, 0.4047794, round(trueOdds[1].ImpliedProb().decimal, 7))
 	assert.Equal(t, 0.2222265, round(trueOdds[2].ImpliedProb().decimal, 7))
+
+	twoWay := []Odds{NewOddsFromDecimal(1.8), NewOddsFromDecimal(2.1)}
+	trueOdds = ShinOdds(twoWay...)
+	additive := AdditiveOdds(twoWay...)
+	assert.InDelta(t, additive[0].decimalOdds, trueOdds[0].decimalOdds, 1e-9)
+	assert.InDelta(t, additive[1].decimalOdds, trueOdds[1].decimalOdds, 1e-9)
+	assert.InDelta(t, 1.0, probSum(trueOdds...), 1e-12)
 }
 
 func TestOddsRatioOdds(t *testing.T) {
@@ -236,3 +262,52 @@ func TestLogarithmicOdds(t *testing.T) {
 	assert.Equal(t, 3.6888, round(trueOdds[1].decimalOdds, 4))
 	assert.Equal(t, 3.8778, round(trueOdds[2].decimalOdds, 4))
 }
+
+func TestOverround(t *testing.T) {
+	odds1 := NewOddsFromAmerican(-110.0)
+	odds2 := NewOddsFromAmerican(-110.0)
+	assert.InDelta(t, 1.0476, Overround(odds1, odds2), 0.0001)
+	assert.InDelta(t, 4.5455, HoldPercent(odds1, odds2), 0.0001)
+	assert.InDelta(t, 0.0238, VigPerSide(odds1, odds2), 0.0001)
+	assert.InDelta(t, 1.0, Overround(NewOddsFromDecimal(2.0), NewOddsFromDecimal(2.0)), 1e-12)
+}
+
+func TestNoVigWidth(t *testing.T) {
+	assert.InDelta(t, 20.0, NoVigWidth(NewOddsFromAmerican(-110.0), NewOddsFromAmerican(-110.0)), 1e-9)
+	assert.InDelta(t, 14.4, NoVigWidth(NewOddsFromAmerican(-150.0), NewOddsFromAmerican(+130.0)), 0.1)
+	// A 50 cent MarketWidth on a big favorite holds about as much as a 15 cent
+	// pick'em market.
+	odds1 := NewOddsFromAmerican(-300.0)
+	odds2 := NewOddsFromAmerican(+250.0)
+	assert.Equal(t, 50.0, MarketWidth(odds1, odds2))
+	assert.InDelta(t, 14.8, NoVigWidth(odds1, odds2), 0.1)
+	assert.InDelta(t, WidthToHold(NoVigWidth(odds1, odds2)), HoldPercent(odds1, odds2), 1e-9)
+}
+
+func TestWidthToHold(t *testing.T) {
+	assert.InDelta(t, 4.5455, WidthToHold(20.0), 0.0001)
+	assert.InDelta(t, 0.0, WidthToHold(0.0), 1e-12)
+	assert.InDelta(t, 20.0, HoldToWidth(WidthToHold(20.0)), 1e-9)
+	assert.InDelta(t, 10.0, HoldToWidth(WidthToHold(10.0)), 1e-9)
+}
+
+func TestProbability_Accessors(t *testing.T) {
+	prob := NewProbabilityFromPercent(25.0)
+	assert.Equal(t, 0.25, prob.Decimal())
+	assert.Equal(t, 25.0, prob.Percent())
+}
+
+func TestAverageOdds_Empty(t *testing.T) {
+	ao := NewAverageOdds()
+	assert.Equal(t, 0, ao.Count())
+	assert.Equal(t, Odds{}, ao.Average())
+	_, ok := ao.AverageOk()
+	assert.False(t, ok)
+
+	ao = dummyAverageOdds()
+	assert.Equal(t, 3, ao.Count())
+	odds, ok := ao.AverageOk()
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, odds.decimalOdds)
+	assert.Equal(t, Odds{}, ao.AverageWithout(NewOddsFromDecimal(5.0), 3))
+}
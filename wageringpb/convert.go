@@ -0,0 +1,86 @@
+package wageringpb
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/dburger/wagering"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OddsToProto returns the protocol buffer message of odds.
+func OddsToProto(odds wagering.Odds) *Odds {
+	return &Odds{Decimal: odds.Decimal(), American: odds.American()}
+}
+
+// OddsFromProto returns the wagering.Odds of the protocol buffer message, the zero
+// Odds if nil. Both formats are restored exactly, as by the binary encoding of
+// wagering.Odds, rather than one being recomputed from the other.
+func OddsFromProto(pb *Odds) wagering.Odds {
+	var odds wagering.Odds
+	if pb == nil {
+		return odds
+	}
+	b := binary.BigEndian.AppendUint64(nil, math.Float64bits(pb.GetDecimal()))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(pb.GetAmerican()))
+	// The encoding is always of the right length.
+	_ = odds.UnmarshalBinary(b)
+	return odds
+}
+
+// MarketToProto returns the protocol buffer message of m.
+func MarketToProto(m wagering.Market) *Market {
+	pb := &Market{Event: m.Event, Name: m.Name, Book: m.Book}
+	for _, o := range m.Outcomes {
+		pb.Outcomes = append(pb.Outcomes, &Outcome{Name: o.Name, Odds: OddsToProto(o.Odds)})
+	}
+	return pb
+}
+
+// MarketFromProto returns the wagering.Market of the protocol buffer message, the
+// zero Market if nil.
+func MarketFromProto(pb *Market) wagering.Market {
+	m := wagering.Market{Event: pb.GetEvent(), Name: pb.GetName(), Book: pb.GetBook()}
+	for _, o := range pb.GetOutcomes() {
+		m.Outcomes = append(m.Outcomes, wagering.Outcome{Name: o.GetName(), Odds: OddsFromProto(o.GetOdds())})
+	}
+	return m
+}
+
+// QuoteToProto returns the protocol buffer message of q. The zero Time is sent as
+// an unset time.
+func QuoteToProto(q wagering.Quote) *Quote {
+	pb := &Quote{
+		Event:   q.Event,
+		Market:  q.Market,
+		Book:    q.Book,
+		Outcome: q.Outcome,
+		Odds:    OddsToProto(q.Odds),
+		Fair:    q.Fair.Decimal(),
+		Ev:      q.EV,
+	}
+	if !q.Time.IsZero() {
+		pb.Time = timestamppb.New(q.Time)
+	}
+	return pb
+}
+
+// QuoteFromProto returns the wagering.Quote of the protocol buffer message, the
+// zero Quote if nil. An unset time is the zero Time.
+func QuoteFromProto(pb *Quote) wagering.Quote {
+	q := wagering.Quote{
+		Event:   pb.GetEvent(),
+		Market:  pb.GetMarket(),
+		Book:    pb.GetBook(),
+		Outcome: pb.GetOutcome(),
+		Odds:    OddsFromProto(pb.GetOdds()),
+		EV:      pb.GetEv(),
+	}
+	if pb.GetFair() != 0.0 {
+		q.Fair = wagering.NewProbabilityFromDecimal(pb.GetFair())
+	}
+	if pb.GetTime() != nil {
+		q.Time = pb.GetTime().AsTime()
+	}
+	return q
+}
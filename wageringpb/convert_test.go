@@ -0,0 +1,58 @@
+package wageringpb
+
+import (
+	"github.com/dburger/wagering"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+func TestOdds_Proto(t *testing.T) {
+	odds := wagering.NewOddsFromAmerican(-110.0)
+	data, err := proto.Marshal(OddsToProto(odds))
+	assert.NoError(t, err)
+	var pb Odds
+	assert.NoError(t, proto.Unmarshal(data, &pb))
+	assert.Equal(t, odds, OddsFromProto(&pb))
+	assert.Equal(t, wagering.Odds{}, OddsFromProto(nil))
+
+	// Odds from decimal keep their computed american odds, and vice versa.
+	odds = wagering.NewOddsFromDecimal(1.91)
+	assert.Equal(t, odds, OddsFromProto(OddsToProto(odds)))
+}
+
+func TestMarket_Proto(t *testing.T) {
+	m := wagering.Market{Event: "NYJ @ NE", Name: "moneyline", Book: "pinnacle", Outcomes: []wagering.Outcome{
+		{Name: "NE", Odds: wagering.NewOddsFromAmerican(-150.0)},
+		{Name: "NYJ", Odds: wagering.NewOddsFromAmerican(+130.0)},
+	}}
+	data, err := proto.Marshal(MarketToProto(m))
+	assert.NoError(t, err)
+	var pb Market
+	assert.NoError(t, proto.Unmarshal(data, &pb))
+	assert.Equal(t, m, MarketFromProto(&pb))
+	assert.Equal(t, wagering.Market{}, MarketFromProto(nil))
+}
+
+func TestQuote_Proto(t *testing.T) {
+	q := wagering.Quote{
+		Event:   "NYJ @ NE",
+		Market:  "moneyline",
+		Book:    "bookA",
+		Outcome: "NE",
+		Odds:    wagering.NewOddsFromAmerican(-120.0),
+		Time:    time.Date(2024, 9, 8, 9, 0, 0, 0, time.UTC),
+		Fair:    wagering.NewProbabilityFromDecimal(0.55),
+		EV:      0.0083,
+	}
+	data, err := proto.Marshal(QuoteToProto(q))
+	assert.NoError(t, err)
+	var pb Quote
+	assert.NoError(t, proto.Unmarshal(data, &pb))
+	assert.Equal(t, q, QuoteFromProto(&pb))
+
+	q = wagering.Quote{Outcome: "NE", Odds: wagering.NewOddsFromDecimal(1.8)}
+	assert.Nil(t, QuoteToProto(q).Time)
+	assert.Equal(t, q, QuoteFromProto(QuoteToProto(q)))
+}
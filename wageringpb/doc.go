@@ -0,0 +1,7 @@
+// Package wageringpb holds the protocol buffer messages of wagering.proto, the
+// wire representations of the core types of package wagering, and the conversions
+// to and from them. It is kept apart from package wagering so that only users of
+// the messages depend on protocol buffers.
+package wageringpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative wagering.proto
@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: wagering.proto
+
+package wageringpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Odds holds both formats so that a round trip is lossless.
+type Odds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decimal       float64                `protobuf:"fixed64,1,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	American      float64                `protobuf:"fixed64,2,opt,name=american,proto3" json:"american,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Odds) Reset() {
+	*x = Odds{}
+	mi := &file_wagering_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Odds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Odds) ProtoMessage() {}
+
+func (x *Odds) ProtoReflect() protoreflect.Message {
+	mi := &file_wagering_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Odds.ProtoReflect.Descriptor instead.
+func (*Odds) Descriptor() ([]byte, []int) {
+	return file_wagering_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Odds) GetDecimal() float64 {
+	if x != nil {
+		return x.Decimal
+	}
+	return 0
+}
+
+func (x *Odds) GetAmerican() float64 {
+	if x != nil {
+		return x.American
+	}
+	return 0
+}
+
+// Outcome is a named outcome of a Market and its odds.
+type Outcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Odds          *Odds                  `protobuf:"bytes,2,opt,name=odds,proto3" json:"odds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Outcome) Reset() {
+	*x = Outcome{}
+	mi := &file_wagering_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Outcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outcome) ProtoMessage() {}
+
+func (x *Outcome) ProtoReflect() protoreflect.Message {
+	mi := &file_wagering_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outcome.ProtoReflect.Descriptor instead.
+func (*Outcome) Descriptor() ([]byte, []int) {
+	return file_wagering_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Outcome) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Outcome) GetOdds() *Odds {
+	if x != nil {
+		return x.Odds
+	}
+	return nil
+}
+
+// Market is the odds of each outcome of a market on an event quoted by a book.
+type Market struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Book          string                 `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	Outcomes      []*Outcome             `protobuf:"bytes,4,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	mi := &file_wagering_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	mi := &file_wagering_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_wagering_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Market) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Market) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Market) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *Market) GetOutcomes() []*Outcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+// Quote is a price for an outcome of a market quoted by a book at a point in time.
+type Quote struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Event   string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Market  string                 `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	Book    string                 `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	Outcome string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Odds    *Odds                  `protobuf:"bytes,5,opt,name=odds,proto3" json:"odds,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	// fair is the decimal fair probability of the outcome, zero if unpriced.
+	Fair float64 `protobuf:"fixed64,7,opt,name=fair,proto3" json:"fair,omitempty"`
+	// ev is the expected value of wagering at odds against fair, as a decimal.
+	Ev            float64 `protobuf:"fixed64,8,opt,name=ev,proto3" json:"ev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_wagering_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_wagering_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_wagering_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Quote) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Quote) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *Quote) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *Quote) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *Quote) GetOdds() *Odds {
+	if x != nil {
+		return x.Odds
+	}
+	return nil
+}
+
+func (x *Quote) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Quote) GetFair() float64 {
+	if x != nil {
+		return x.Fair
+	}
+	return 0
+}
+
+func (x *Quote) GetEv() float64 {
+	if x != nil {
+		return x.Ev
+	}
+	return 0
+}
+
+var File_wagering_proto protoreflect.FileDescriptor
+
+const file_wagering_proto_rawDesc = "" +
+	"\n" +
+	"\x0ewagering.proto\x12\vwagering.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"<\n" +
+	"\x04Odds\x12\x18\n" +
+	"\adecimal\x18\x01 \x01(\x01R\adecimal\x12\x1a\n" +
+	"\bamerican\x18\x02 \x01(\x01R\bamerican\"D\n" +
+	"\aOutcome\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x04odds\x18\x02 \x01(\v2\x11.wagering.v1.OddsR\x04odds\"x\n" +
+	"\x06Market\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04book\x18\x03 \x01(\tR\x04book\x120\n" +
+	"\boutcomes\x18\x04 \x03(\v2\x14.wagering.v1.OutcomeR\boutcomes\"\xde\x01\n" +
+	"\x05Quote\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x16\n" +
+	"\x06market\x18\x02 \x01(\tR\x06market\x12\x12\n" +
+	"\x04book\x18\x03 \x01(\tR\x04book\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12%\n" +
+	"\x04odds\x18\x05 \x01(\v2\x11.wagering.v1.OddsR\x04odds\x12.\n" +
+	"\x04time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04fair\x18\a \x01(\x01R\x04fair\x12\x0e\n" +
+	"\x02ev\x18\b \x01(\x01R\x02evB(Z&github.com/dburger/wagering/wageringpbb\x06proto3"
+
+var (
+	file_wagering_proto_rawDescOnce sync.Once
+	file_wagering_proto_rawDescData []byte
+)
+
+func file_wagering_proto_rawDescGZIP() []byte {
+	file_wagering_proto_rawDescOnce.Do(func() {
+		file_wagering_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wagering_proto_rawDesc), len(file_wagering_proto_rawDesc)))
+	})
+	return file_wagering_proto_rawDescData
+}
+
+var file_wagering_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wagering_proto_goTypes = []any{
+	(*Odds)(nil),                  // 0: wagering.v1.Odds
+	(*Outcome)(nil),               // 1: wagering.v1.Outcome
+	(*Market)(nil),                // 2: wagering.v1.Market
+	(*Quote)(nil),                 // 3: wagering.v1.Quote
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_wagering_proto_depIdxs = []int32{
+	0, // 0: wagering.v1.Outcome.odds:type_name -> wagering.v1.Odds
+	1, // 1: wagering.v1.Market.outcomes:type_name -> wagering.v1.Outcome
+	0, // 2: wagering.v1.Quote.odds:type_name -> wagering.v1.Odds
+	4, // 3: wagering.v1.Quote.time:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_wagering_proto_init() }
+func file_wagering_proto_init() {
+	if File_wagering_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wagering_proto_rawDesc), len(file_wagering_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wagering_proto_goTypes,
+		DependencyIndexes: file_wagering_proto_depIdxs,
+		MessageInfos:      file_wagering_proto_msgTypes,
+	}.Build()
+	File_wagering_proto = out.File
+	file_wagering_proto_goTypes = nil
+	file_wagering_proto_depIdxs = nil
+}